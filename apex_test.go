@@ -1,6 +1,7 @@
 package bench
 
 import (
+	"context"
 	"io"
 
 	apex "github.com/apex/log"
@@ -30,6 +31,19 @@ func newApex(w io.Writer) *apex.Logger {
 	}
 }
 
+// apexEnabled reports whether level is enabled for l, as apex has no API to
+// check it.
+func apexEnabled(l apex.Interface, level apex.Level) bool {
+	switch l := l.(type) {
+	case *apex.Logger:
+		return level >= l.Level
+	case *apex.Entry:
+		return level >= l.Logger.Level
+	}
+
+	return true
+}
+
 type apexBench struct {
 	l apex.Interface
 }
@@ -46,6 +60,18 @@ func (b *apexBench) newWithCtx(w io.Writer) logBenchmark {
 	}
 }
 
+func (b *apexBench) newWithGroups(w io.Writer) logBenchmark {
+	return &apexBench{
+		l: newApex(w).WithFields(apex.Fields(groupFields())),
+	}
+}
+
+// newWithLevelSwitch returns no switch as the Level field of apex.Logger is
+// read without synchronization.
+func (b *apexBench) newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool)) {
+	return b.new(w), nil
+}
+
 func (b *apexBench) name() string {
 	return "Apex"
 }
@@ -54,6 +80,14 @@ func (b *apexBench) logEvent(msg string) {
 	b.l.Info(msg)
 }
 
+func (b *apexBench) logEventWarn(msg string) {
+	b.l.Warn(msg)
+}
+
+func (b *apexBench) logEventError(msg string) {
+	b.l.Error(msg)
+}
+
 func (b *apexBench) logEventFmt(msg string, args ...any) {
 	b.l.Infof(msg, args...)
 }
@@ -66,6 +100,59 @@ func (b *apexBench) logEventCtxWeak(msg string) {
 	b.logEventCtx(msg)
 }
 
+func (b *apexBench) logEventCtxWarn(msg string) {
+	b.l.WithFields(apexFields()).Warn(msg)
+}
+
+func (b *apexBench) logEventCtxError(msg string) {
+	b.l.WithFields(apexFields()).Error(msg)
+}
+
+func (b *apexBench) logEventErrorStack(msg string, err error) {
+	b.l.WithError(err).WithField("stack", stackTrace()).Error(msg)
+}
+
+func (b *apexBench) logEventKV(msg string, keysAndValues ...any) {
+	b.l.WithFields(apex.Fields(keyValueMap(keysAndValues))).Info(msg)
+}
+
+func (b *apexBench) logEventField(msg string, kind fieldKind) {
+	key, value := kind.keyValue()
+	b.l.WithField(key, value).Info(msg)
+}
+
+func (b *apexBench) withTraceContext(ctx context.Context) context.Context {
+	return apex.NewContext(ctx, b.l.WithFields(apex.Fields{
+		"trace_id": ctxTraceID,
+		"span_id":  ctxSpanID,
+	}))
+}
+
+func (b *apexBench) logEventFromContext(ctx context.Context, msg string) {
+	apex.FromContext(ctx).Info(msg)
+}
+
+func (b *apexBench) logEventLazy(msg string) {
+	b.l.WithField("digest", lazyDigest{}).Info(msg)
+}
+
+func (b *apexBench) logRequest(msg string) {
+	l := b.l.WithFields(apex.Fields{
+		"request_id":  reqID,
+		"method":      reqMethod,
+		"path":        reqPath,
+		"remote_addr": reqRemoteAddr,
+	})
+
+	for i := 0; i < requestEvents; i++ {
+		l.Info(msg)
+	}
+}
+
+func (b *apexBench) logEventGroups(msg string) {
+	b.l.WithFields(apex.Fields(groupFields())).Info(msg)
+}
+
 func (b *apexBench) logDisabled(msg string) {
 	b.l.Debug(msg)
 }
@@ -78,6 +165,16 @@ func (b *apexBench) logDisabledCtx(msg string) {
 	b.l.WithFields(apexFields()).Debug(msg)
 }
 
+func (b *apexBench) logDisabledCtxGuarded(msg string) {
+	if apexEnabled(b.l, apex.DebugLevel) {
+		b.l.WithFields(apexFields()).Debug(msg)
+	}
+}
+
 func (b *apexBench) logDisabledCtxWeak(msg string) {
 	b.logDisabledCtx(msg)
 }
+
+func (b *apexBench) logDisabledLazy(msg string) {
+	b.l.WithField("digest", lazyDigest{}).Debug(msg)
+}
@@ -1,8 +1,13 @@
 package bench
 
 import (
+	"bytes"
+	"context"
 	"io"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 )
 
 // BenchmarkEvent tests the performance of logging a simple message with no
@@ -17,19 +22,59 @@ func BenchmarkEvent(b *testing.B) {
 
 			b.ResetTimer()
 
-			b.RunParallel(func(pb *testing.PB) {
+			runParallel(b, func(pb *testing.PB) {
 				for pb.Next() {
 					l.logEvent(logMsg)
 				}
 			})
 
-			if out.WriteCount() != uint64(b.N) {
-				b.Fatalf(
-					"Mismatch in log write count. Expected: %d, Actual: %d",
-					b.N,
-					out.WriteCount(),
-				)
-			}
+			reportWrites(b, out, uint64(b.N), nil)
+		})
+	}
+}
+
+// BenchmarkEventWarn tests the performance of logging a simple message at the
+// Warn level, where some libraries take a different path than at Info.
+func BenchmarkEventWarn(b *testing.B) {
+	b.Logf("Log a simple message at the Warn level")
+
+	benchmarkLevel(b, "warn", logBenchmark.logEventWarn)
+}
+
+// BenchmarkEventError tests the performance of logging a simple message at
+// the Error level, where some libraries take a different path than at Info.
+// Zap and ZapSugar are configured like zap.NewProduction and capture a stack
+// trace at this level. SlogZap does not, as zapslog has no option for it, and
+// the other libraries have no automatic stack capture.
+func BenchmarkEventError(b *testing.B) {
+	b.Logf("Log a simple message at the Error level")
+
+	benchmarkLevel(b, "error", logBenchmark.logEventError)
+}
+
+// benchmarkLevel runs log against every library, checking once that the
+// event is written at the given level, and reporting the problems found as
+// output-errors, before running the benchmark.
+func benchmarkLevel(b *testing.B, level string, log func(l logBenchmark, msg string)) {
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			var buf bytes.Buffer
+			log(v.new(&buf), logMsg)
+
+			problems := checkLevelOutput(buf.Bytes(), logMsg, level)
+
+			out := &blackhole{}
+			l := v.new(out)
+
+			b.ResetTimer()
+
+			runParallel(b, func(pb *testing.PB) {
+				for pb.Next() {
+					log(l, logMsg)
+				}
+			})
+
+			reportWrites(b, out, uint64(b.N), problems)
 		})
 	}
 }
@@ -41,15 +86,20 @@ func BenchmarkDisabled(b *testing.B) {
 
 	for _, v := range loggers {
 		b.Run(v.name(), func(b *testing.B) {
-			l := v.new(io.Discard)
+			out := &blackhole{}
+			l := v.new(out)
 
 			b.ResetTimer()
 
-			b.RunParallel(func(pb *testing.PB) {
+			runParallel(b, func(pb *testing.PB) {
 				for pb.Next() {
 					l.logDisabled(logMsg)
 				}
 			})
+
+			if out.WriteCount() != 0 {
+				b.Fatalf("Disabled event was written %d times", out.WriteCount())
+			}
 		})
 	}
 }
@@ -59,26 +109,29 @@ func BenchmarkDisabled(b *testing.B) {
 func BenchmarkEventFmt(b *testing.B) {
 	b.Logf("Log a simple message using string formatting verbs")
 
+	if err := checkFmtFixture(logMsgFmt, logMsgArgs); err != nil {
+		b.Fatal(err)
+	}
+
 	for _, v := range loggers {
 		b.Run(v.name(), func(b *testing.B) {
+			var buf bytes.Buffer
+			v.new(&buf).logEventFmt(logMsgFmt, logMsgArgs...)
+
+			problems := checkFmtOutput(buf.Bytes(), logMsgFmt, logMsgArgs)
+
 			out := &blackhole{}
 			l := v.new(out)
 
 			b.ResetTimer()
 
-			b.RunParallel(func(pb *testing.PB) {
+			runParallel(b, func(pb *testing.PB) {
 				for pb.Next() {
 					l.logEventFmt(logMsgFmt, logMsgArgs...)
 				}
 			})
 
-			if out.WriteCount() != uint64(b.N) {
-				b.Fatalf(
-					"Mismatch in log write count. Expected: %d, Actual: %d",
-					b.N,
-					out.WriteCount(),
-				)
-			}
+			reportWrites(b, out, uint64(b.N), problems)
 		})
 	}
 }
@@ -88,17 +141,26 @@ func BenchmarkEventFmt(b *testing.B) {
 func BenchmarkDisabledFmt(b *testing.B) {
 	b.Logf("Log at a disabled level with string formatting verbs")
 
+	if err := checkFmtFixture(logMsgFmt, logMsgArgs); err != nil {
+		b.Fatal(err)
+	}
+
 	for _, v := range loggers {
 		b.Run(v.name(), func(b *testing.B) {
-			l := v.new(io.Discard)
+			out := &blackhole{}
+			l := v.new(out)
 
 			b.ResetTimer()
 
-			b.RunParallel(func(pb *testing.PB) {
+			runParallel(b, func(pb *testing.PB) {
 				for pb.Next() {
 					l.logDisabledFmt(logMsgFmt, logMsgArgs...)
 				}
 			})
+
+			if out.WriteCount() != 0 {
+				b.Fatalf("Disabled event was written %d times", out.WriteCount())
+			}
 		})
 	}
 }
@@ -115,23 +177,34 @@ func BenchmarkEventCtx(b *testing.B) {
 
 			b.ResetTimer()
 
-			b.RunParallel(func(pb *testing.PB) {
+			runParallel(b, func(pb *testing.PB) {
 				for pb.Next() {
 					l.logEventCtx(logMsg)
 				}
 			})
 
-			if out.WriteCount() != uint64(b.N) {
-				b.Fatalf(
-					"Mismatch in log write count. Expected: %d, Actual: %d",
-					b.N,
-					out.WriteCount(),
-				)
-			}
+			reportWrites(b, out, uint64(b.N), nil)
 		})
 	}
 }
 
+// BenchmarkEventCtxWarn tests the performance of logging an event with
+// several contextual fields at the Warn level.
+func BenchmarkEventCtxWarn(b *testing.B) {
+	b.Logf("Log an event with several contextual fields at the Warn level")
+
+	benchmarkLevel(b, "warn", logBenchmark.logEventCtxWarn)
+}
+
+// BenchmarkEventCtxError tests the performance of logging an event with
+// several contextual fields at the Error level. As in BenchmarkEventError,
+// only Zap and ZapSugar capture a stack trace.
+func BenchmarkEventCtxError(b *testing.B) {
+	b.Logf("Log an event with several contextual fields at the Error level")
+
+	benchmarkLevel(b, "error", logBenchmark.logEventCtxError)
+}
+
 // BenchmarkDisabledCtx tests the performance impact of logging an event
 // at a disabled level with several contextual fields.
 func BenchmarkDisabledCtx(b *testing.B) {
@@ -139,15 +212,46 @@ func BenchmarkDisabledCtx(b *testing.B) {
 
 	for _, v := range loggers {
 		b.Run(v.name(), func(b *testing.B) {
-			l := v.new(io.Discard)
+			out := &blackhole{}
+			l := v.new(out)
 
 			b.ResetTimer()
 
-			b.RunParallel(func(pb *testing.PB) {
+			runParallel(b, func(pb *testing.PB) {
 				for pb.Next() {
 					l.logDisabledCtx(logMsg)
 				}
 			})
+
+			if out.WriteCount() != 0 {
+				b.Fatalf("Disabled event was written %d times", out.WriteCount())
+			}
+		})
+	}
+}
+
+// BenchmarkDisabledCtxGuarded tests the same disabled event as
+// BenchmarkDisabledCtx, guarded by the library's API for checking whether a
+// level is enabled, to show whether guarding expensive debug logs pays off.
+func BenchmarkDisabledCtxGuarded(b *testing.B) {
+	b.Logf("Log a disabled event with several contextual fields behind a level check")
+
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			out := &blackhole{}
+			l := v.new(out)
+
+			b.ResetTimer()
+
+			runParallel(b, func(pb *testing.PB) {
+				for pb.Next() {
+					l.logDisabledCtxGuarded(logMsg)
+				}
+			})
+
+			if out.WriteCount() != 0 {
+				b.Fatalf("Disabled event was written %d times", out.WriteCount())
+			}
 		})
 	}
 }
@@ -164,19 +268,13 @@ func BenchmarkEventCtxWeak(b *testing.B) {
 
 			b.ResetTimer()
 
-			b.RunParallel(func(pb *testing.PB) {
+			runParallel(b, func(pb *testing.PB) {
 				for pb.Next() {
 					l.logEventCtxWeak(logMsg)
 				}
 			})
 
-			if out.WriteCount() != uint64(b.N) {
-				b.Fatalf(
-					"Mismatch in log write count. Expected: %d, Actual: %d",
-					b.N,
-					out.WriteCount(),
-				)
-			}
+			reportWrites(b, out, uint64(b.N), nil)
 		})
 	}
 }
@@ -188,15 +286,20 @@ func BenchmarkDisabledCtxWeak(b *testing.B) {
 
 	for _, v := range loggers {
 		b.Run(v.name(), func(b *testing.B) {
-			l := v.newWithCtx(io.Discard)
+			out := &blackhole{}
+			l := v.newWithCtx(out)
 
 			b.ResetTimer()
 
-			b.RunParallel(func(pb *testing.PB) {
+			runParallel(b, func(pb *testing.PB) {
 				for pb.Next() {
 					l.logDisabledCtxWeak(logMsg)
 				}
 			})
+
+			if out.WriteCount() != 0 {
+				b.Fatalf("Disabled event was written %d times", out.WriteCount())
+			}
 		})
 	}
 }
@@ -213,19 +316,13 @@ func BenchmarkEventAccumulatedCtx(b *testing.B) {
 
 			b.ResetTimer()
 
-			b.RunParallel(func(pb *testing.PB) {
+			runParallel(b, func(pb *testing.PB) {
 				for pb.Next() {
 					l.logEvent(logMsg)
 				}
 			})
 
-			if out.WriteCount() != uint64(b.N) {
-				b.Fatalf(
-					"Mismatch in log write count. Expected: %d, Actual: %d",
-					b.N,
-					out.WriteCount(),
-				)
-			}
+			reportWrites(b, out, uint64(b.N), nil)
 		})
 	}
 }
@@ -237,15 +334,510 @@ func BenchmarkDisabledAccumulatedCtx(b *testing.B) {
 
 	for _, v := range loggers {
 		b.Run(v.name(), func(b *testing.B) {
-			l := v.newWithCtx(io.Discard)
+			out := &blackhole{}
+			l := v.newWithCtx(out)
+
+			b.ResetTimer()
+
+			runParallel(b, func(pb *testing.PB) {
+				for pb.Next() {
+					l.logDisabled(logMsg)
+				}
+			})
+
+			if out.WriteCount() != 0 {
+				b.Fatalf("Disabled event was written %d times", out.WriteCount())
+			}
+		})
+	}
+}
+
+// levelSwitchInterval is how often BenchmarkLevelSwitch flips the level.
+const levelSwitchInterval = 100 * time.Microsecond
+
+// BenchmarkLevelSwitch tests the impact of changing the log level from a
+// background goroutine while events are being logged in parallel.
+func BenchmarkLevelSwitch(b *testing.B) {
+	b.Logf("Log a debug event while the level switches between Info and Debug")
+
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			out := &blackhole{}
+			l, setDebug := v.newWithLevelSwitch(out)
+			if setDebug == nil {
+				b.Skipf("%s cannot change its level at runtime without a data race", v.name())
+			}
+
+			// Zerolog switches the process-wide global level, which must be
+			// back at Debug for the benchmarks that follow even if this one
+			// stops early.
+			b.Cleanup(func() { setDebug(true) })
+
+			setDebug(false)
+
+			done := make(chan struct{})
+			var wg sync.WaitGroup
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				ticker := time.NewTicker(levelSwitchInterval)
+				defer ticker.Stop()
+
+				debug := false
+				for {
+					select {
+					case <-done:
+						return
+					case <-ticker.C:
+						debug = !debug
+						setDebug(debug)
+					}
+				}
+			}()
 
 			b.ResetTimer()
 
-			b.RunParallel(func(pb *testing.PB) {
+			runParallel(b, func(pb *testing.PB) {
 				for pb.Next() {
 					l.logDisabled(logMsg)
 				}
 			})
+
+			b.StopTimer()
+			close(done)
+			wg.Wait()
+
+			checkLevelSchedule(b, l, setDebug, out)
+		})
+	}
+}
+
+// checkLevelSchedule switches the level through a fixed schedule and checks
+// that debug events are written only while Debug is enabled, one line per
+// event.
+func checkLevelSchedule(
+	b *testing.B,
+	l logBenchmark,
+	setDebug func(debug bool),
+	out *blackhole,
+) {
+	const events = 10
+
+	for _, debug := range []bool{false, true, false, true} {
+		setDebug(debug)
+
+		before := out.LineCount()
+		for i := 0; i < events; i++ {
+			l.logDisabled(logMsg)
+		}
+
+		var expected uint64
+		if debug {
+			expected = events
+		}
+
+		if written := out.LineCount() - before; written != expected {
+			b.Fatalf(
+				"Mismatch in logged line count with debug=%t. Expected: %d, Actual: %d",
+				debug,
+				expected,
+				written,
+			)
+		}
+	}
+}
+
+// BenchmarkEventFromContext tests the performance of retrieving a logger, or
+// the fields it should add, from a request context for every event. The
+// trace and span IDs of a single event are checked first and any problem is
+// reported as output-errors.
+func BenchmarkEventFromContext(b *testing.B) {
+	b.Logf("Log an event with a logger and trace IDs taken from a context")
+
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			var buf bytes.Buffer
+			c := v.new(&buf)
+			c.logEventFromContext(c.withTraceContext(context.Background()), logMsg)
+
+			problems := checkOutput(buf.Bytes(), logMsg, []any{
+				"trace_id", ctxTraceID,
+				"span_id", ctxSpanID,
+			})
+
+			out := &blackhole{}
+			l := v.new(out)
+			ctx := l.withTraceContext(context.Background())
+
+			b.ResetTimer()
+
+			runParallel(b, func(pb *testing.PB) {
+				for pb.Next() {
+					l.logEventFromContext(ctx, logMsg)
+				}
+			})
+
+			reportWrites(b, out, uint64(b.N), problems)
+		})
+	}
+}
+
+// BenchmarkEventLazy tests the cost of logging a field that is expensive to
+// compute, deferred through the lazy evaluation mechanism of each library.
+// The expensive-calls/op metric reports how often it was computed.
+func BenchmarkEventLazy(b *testing.B) {
+	b.Logf("Log an event with an expensive field that is evaluated lazily")
+
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			out := &blackhole{}
+			l := v.new(out)
+
+			b.ResetTimer()
+			expensiveCalls.Store(0)
+
+			runParallel(b, func(pb *testing.PB) {
+				for pb.Next() {
+					l.logEventLazy(logMsg)
+				}
+			})
+
+			reportWrites(b, out, uint64(b.N), nil)
+
+			b.ReportMetric(float64(expensiveCalls.Load())/float64(b.N), "expensive-calls/op")
+		})
+	}
+}
+
+// BenchmarkDisabledLazy tests whether each library computes an expensive
+// field that it discards because the level is disabled. The
+// expensive-calls/op metric should be zero.
+func BenchmarkDisabledLazy(b *testing.B) {
+	b.Logf("Log at a disabled level with an expensive field that is evaluated lazily")
+
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			out := &blackhole{}
+			l := v.new(out)
+
+			b.ResetTimer()
+			expensiveCalls.Store(0)
+
+			runParallel(b, func(pb *testing.PB) {
+				for pb.Next() {
+					l.logDisabledLazy(logMsg)
+				}
+			})
+
+			if out.WriteCount() != 0 {
+				b.Fatalf("Disabled event was written %d times", out.WriteCount())
+			}
+
+			b.ReportMetric(float64(expensiveCalls.Load())/float64(b.N), "expensive-calls/op")
+		})
+	}
+}
+
+// BenchmarkEventChildLogger tests the lifecycle of a per-request logger:
+// deriving a child logger with the request fields, logging a few events with
+// it and discarding it. Each operation is one request, so ns/op, B/op and
+// allocs/op are per request.
+func BenchmarkEventChildLogger(b *testing.B) {
+	b.Logf("Derive a child logger with request fields and log a few events per request")
+
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			out := &blackhole{}
+			l := v.new(out)
+
+			b.ResetTimer()
+
+			runParallelEvents(b, requestEvents, func(pb *testing.PB) {
+				for pb.Next() {
+					l.logRequest(logMsg)
+				}
+			})
+
+			reportWrites(b, out, uint64(b.N)*requestEvents, nil)
+		})
+	}
+}
+
+// BenchmarkEventGroups tests the performance of logging an event with fields
+// nested in groups, using the native grouping of each library.
+func BenchmarkEventGroups(b *testing.B) {
+	b.Logf("Log an event with fields nested in groups")
+
+	benchmarkGroups(b, func(v logBenchmark, w io.Writer) logBenchmark {
+		return v.new(w)
+	}, logBenchmark.logEventGroups)
+}
+
+// BenchmarkEventAccumulatedGroups tests the performance of logging an event
+// with a logger that has accumulated fields nested in groups.
+func BenchmarkEventAccumulatedGroups(b *testing.B) {
+	b.Logf("Log an event with some accumulated fields nested in groups")
+
+	benchmarkGroups(b, logBenchmark.newWithGroups, logBenchmark.logEvent)
+}
+
+// benchmarkGroups checks the nesting of the fields logged by each library
+// once, reporting the problems found as output-errors, before running the
+// benchmark.
+func benchmarkGroups(
+	b *testing.B,
+	newLogger func(v logBenchmark, w io.Writer) logBenchmark,
+	log func(l logBenchmark, msg string),
+) {
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			var buf bytes.Buffer
+			log(newLogger(v, &buf), logMsg)
+
+			problems := checkGroupsOutput(buf.Bytes(), logMsg)
+
+			out := &blackhole{}
+			l := newLogger(v, out)
+
+			b.ResetTimer()
+
+			runParallel(b, func(pb *testing.PB) {
+				for pb.Next() {
+					log(l, logMsg)
+				}
+			})
+
+			reportWrites(b, out, uint64(b.N), problems)
+		})
+	}
+}
+
+// BenchmarkEventLargeMsg tests the performance of logging a large message,
+// 4 KiB unless changed with -large-msg-size.
+func BenchmarkEventLargeMsg(b *testing.B) {
+	b.Logf("Log an event with a large message")
+
+	benchmarkPayload(b, sizedMsg(*largeMsgSize), nil)
+}
+
+// BenchmarkEventHugeMsg tests the performance of logging a huge message,
+// 64 KiB unless changed with -huge-msg-size.
+func BenchmarkEventHugeMsg(b *testing.B) {
+	b.Logf("Log an event with a huge message")
+
+	benchmarkPayload(b, sizedMsg(*hugeMsgSize), nil)
+}
+
+// BenchmarkEventManyFields tests the performance of logging an event with
+// 100 fields unless changed with -many-fields.
+func BenchmarkEventManyFields(b *testing.B) {
+	b.Logf("Log an event with a large number of fields")
+
+	benchmarkPayload(b, logMsg, numberedFields(*manyFields))
+}
+
+// BenchmarkEventNestedMap tests the performance of logging a map nested 10
+// levels deep unless changed with -nested-depth.
+func BenchmarkEventNestedMap(b *testing.B) {
+	b.Logf("Log an event with a deeply nested map")
+
+	benchmarkPayload(b, logMsg, []any{"nested", nestedMap(*nestedDepth)})
+}
+
+// BenchmarkEventEscaping tests the performance of logging a message and
+// fields that need heavy escaping: quotes, control characters, invalid
+// UTF-8 and emoji.
+func BenchmarkEventEscaping(b *testing.B) {
+	b.Logf("Log an event with strings that need heavy escaping")
+
+	benchmarkPayload(b, escapeMsg, escapeFields)
+}
+
+// benchmarkPayload logs msg with the given fields for each library. The
+// output of a single event is checked first, and the number of problems
+// found is reported as the output-errors metric so that incorrect output is
+// visible next to the timing. Problems listed in knownOutputProblems are
+// marked as known.
+func benchmarkPayload(b *testing.B, msg string, keysAndValues []any) {
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			var buf bytes.Buffer
+			v.new(&buf).logEventKV(msg, keysAndValues...)
+
+			problems := checkOutput(buf.Bytes(), msg, keysAndValues)
+			if known, ok := knownOutputProblem(b); ok && len(problems) > 0 {
+				b.Logf("Known problem: %s", known)
+			}
+
+			out := &blackhole{}
+			l := v.new(out)
+
+			b.ResetTimer()
+
+			runParallel(b, func(pb *testing.PB) {
+				for pb.Next() {
+					l.logEventKV(msg, keysAndValues...)
+				}
+			})
+
+			reportWrites(b, out, uint64(b.N), problems)
+		})
+	}
+}
+
+// BenchmarkFieldInt tests the cost of logging an int.
+func BenchmarkFieldInt(b *testing.B) {
+	b.Logf("Log an event with a single int field")
+
+	benchmarkField(b, fieldInt)
+}
+
+// BenchmarkFieldString tests the cost of logging a string.
+func BenchmarkFieldString(b *testing.B) {
+	b.Logf("Log an event with a single string field")
+
+	benchmarkField(b, fieldString)
+}
+
+// BenchmarkFieldFloat64 tests the cost of logging a float64.
+func BenchmarkFieldFloat64(b *testing.B) {
+	b.Logf("Log an event with a single float64 field")
+
+	benchmarkField(b, fieldFloat64)
+}
+
+// BenchmarkFieldBool tests the cost of logging a bool.
+func BenchmarkFieldBool(b *testing.B) {
+	b.Logf("Log an event with a single bool field")
+
+	benchmarkField(b, fieldBool)
+}
+
+// BenchmarkFieldTime tests the cost of logging a time.Time.
+func BenchmarkFieldTime(b *testing.B) {
+	b.Logf("Log an event with a single time.Time field")
+
+	benchmarkField(b, fieldTime)
+}
+
+// BenchmarkFieldDuration tests the cost of logging a time.Duration.
+func BenchmarkFieldDuration(b *testing.B) {
+	b.Logf("Log an event with a single time.Duration field")
+
+	benchmarkField(b, fieldDuration)
+}
+
+// BenchmarkFieldError tests the cost of logging an error.
+func BenchmarkFieldError(b *testing.B) {
+	b.Logf("Log an event with a single error field")
+
+	benchmarkField(b, fieldError)
+}
+
+// BenchmarkFieldStrings tests the cost of logging a []string.
+func BenchmarkFieldStrings(b *testing.B) {
+	b.Logf("Log an event with a single []string field")
+
+	benchmarkField(b, fieldStrings)
+}
+
+// BenchmarkFieldInts tests the cost of logging an []int.
+func BenchmarkFieldInts(b *testing.B) {
+	b.Logf("Log an event with a single []int field")
+
+	benchmarkField(b, fieldInts)
+}
+
+// BenchmarkFieldObject tests the cost of logging a struct through the
+// marshaler interface of the library, slog.LogValuer for Slog. Compare with
+// BenchmarkFieldObjectReflect to see the gain from writing a marshaler.
+// Libraries that are not in objectMarshalers are skipped, as they would log
+// the struct through reflection.
+func BenchmarkFieldObject(b *testing.B) {
+	b.Logf("Log an event with a single object field using a marshaler")
+
+	benchmarkField(b, fieldObject)
+}
+
+// BenchmarkFieldObjectReflect tests the cost of logging a struct through
+// reflection.
+func BenchmarkFieldObjectReflect(b *testing.B) {
+	b.Logf("Log an event with a single object field using reflection")
+
+	benchmarkField(b, fieldObjectReflect)
+}
+
+// BenchmarkFieldObjects tests the cost of logging a slice of structs through
+// the marshaler interface of the library. Libraries that are not in
+// arrayMarshalers are skipped, as they would log the slice through
+// reflection.
+func BenchmarkFieldObjects(b *testing.B) {
+	b.Logf("Log an event with a single slice of objects using a marshaler")
+
+	benchmarkField(b, fieldObjects)
+}
+
+// BenchmarkFieldObjectsReflect tests the cost of logging a slice of structs
+// through reflection.
+func BenchmarkFieldObjectsReflect(b *testing.B) {
+	b.Logf("Log an event with a single slice of objects using reflection")
+
+	benchmarkField(b, fieldObjectsReflect)
+}
+
+// BenchmarkFieldMap tests the cost of logging a map[string]string.
+func BenchmarkFieldMap(b *testing.B) {
+	b.Logf("Log an event with a single map field")
+
+	benchmarkField(b, fieldMap)
+}
+
+// objectMarshalers and arrayMarshalers hold the libraries with an interface
+// for encoding an object, or a slice of objects, without reflection. The
+// others log ctxUser and ctxUsers through reflection, which would make the
+// marshaler scenarios a copy of their Reflect counterparts.
+var (
+	objectMarshalers = []string{"Zerolog", "Phuslog", "Zap", "ZapSugar", "Slog", "SlogZap"}
+	arrayMarshalers  = []string{"Zerolog", "Zap", "ZapSugar"}
+)
+
+// hasFieldAPI reports whether the library has the API measured by the field
+// scenario of kind. Only the marshaler scenarios are missing for some
+// libraries.
+func hasFieldAPI(v logBenchmark, kind fieldKind) bool {
+	switch kind {
+	case fieldObject:
+		return slices.Contains(objectMarshalers, v.name())
+	case fieldObjects:
+		return slices.Contains(arrayMarshalers, v.name())
+	}
+
+	return true
+}
+
+// benchmarkField logs an event with the single field selected by kind for
+// each library.
+func benchmarkField(b *testing.B, kind fieldKind) {
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			if !hasFieldAPI(v, kind) {
+				b.Skipf("%s has no marshaler for the %s field", v.name(), kind)
+			}
+
+			out := &blackhole{}
+			l := v.new(out)
+
+			b.ResetTimer()
+
+			runParallel(b, func(pb *testing.PB) {
+				for pb.Next() {
+					l.logEventField(logMsg, kind)
+				}
+			})
+
+			reportWrites(b, out, uint64(b.N), nil)
 		})
 	}
 }
@@ -0,0 +1,619 @@
+package bench
+
+import (
+	"context"
+	"io"
+	"runtime"
+	"testing"
+)
+
+// allocBudget is the most a library may allocate per operation in a scenario.
+type allocBudget struct {
+	library  string
+	scenario string
+	allocs   float64
+	bytes    float64
+}
+
+// budgetToolchain is the Go release the budgets were measured with, which the
+// Test job of the CI workflow pins. Allocations differ between releases of
+// the runtime and of log/slog, so TestAllocBudgets is skipped with any other.
+const budgetToolchain = "go1.21.13"
+
+// allocBudgets holds the allocations per operation measured with
+// budgetToolchain for every library and scenario. Every operation logs one
+// event, except in EventChildLogger where it is a request of requestEvents
+// events. A dependency bump that allocates more fails TestAllocBudgets, while
+// one that allocates less can lower its budget.
+var allocBudgets = []allocBudget{
+	{"Zerolog", "Event", 0, 0},
+	{"Phuslog", "Event", 0, 0},
+	{"Zap", "Event", 0, 0},
+	{"ZapSugar", "Event", 1, 16},
+	{"Slog", "Event", 0, 0},
+	{"SlogZap", "Event", 0, 0},
+	{"Apex", "Event", 5, 240},
+	{"Logrus", "Event", 23, 1112},
+	{"Log15", "Event", 20, 1432},
+	{"Logf", "Event", 0, 0},
+
+	{"Zerolog", "Disabled", 0, 0},
+	{"Phuslog", "Disabled", 0, 0},
+	{"Zap", "Disabled", 0, 0},
+	{"ZapSugar", "Disabled", 1, 16},
+	{"Slog", "Disabled", 0, 0},
+	{"SlogZap", "Disabled", 0, 0},
+	{"Apex", "Disabled", 0, 0},
+	{"Logrus", "Disabled", 1, 16},
+	{"Log15", "Disabled", 3, 459},
+	{"Logf", "Disabled", 0, 0},
+
+	{"Zerolog", "EventFmt", 1, 96},
+	{"Phuslog", "EventFmt", 0, 0},
+	{"Zap", "EventFmt", 1, 96},
+	{"ZapSugar", "EventFmt", 1, 96},
+	{"Slog", "EventFmt", 1, 96},
+	{"SlogZap", "EventFmt", 1, 96},
+	{"Apex", "EventFmt", 6, 336},
+	{"Logrus", "EventFmt", 24, 1256},
+	{"Log15", "EventFmt", 21, 1560},
+	{"Logf", "EventFmt", 1, 96},
+
+	{"Zerolog", "DisabledFmt", 0, 0},
+	{"Phuslog", "DisabledFmt", 0, 0},
+	{"Zap", "DisabledFmt", 1, 96},
+	{"ZapSugar", "DisabledFmt", 0, 0},
+	{"Slog", "DisabledFmt", 1, 96},
+	{"SlogZap", "DisabledFmt", 1, 96},
+	{"Apex", "DisabledFmt", 1, 96},
+	{"Logrus", "DisabledFmt", 0, 0},
+	{"Log15", "DisabledFmt", 4, 552},
+	{"Logf", "DisabledFmt", 1, 96},
+
+	{"Zerolog", "EventWarn", 0, 0},
+	{"Phuslog", "EventWarn", 0, 0},
+	{"Zap", "EventWarn", 0, 0},
+	{"ZapSugar", "EventWarn", 1, 16},
+	{"Slog", "EventWarn", 0, 0},
+	{"SlogZap", "EventWarn", 0, 0},
+	{"Apex", "EventWarn", 5, 240},
+	{"Logrus", "EventWarn", 23, 1121},
+	{"Log15", "EventWarn", 20, 1432},
+	{"Logf", "EventWarn", 0, 0},
+
+	{"Zerolog", "EventError", 0, 0},
+	{"Phuslog", "EventError", 0, 0},
+	{"Zap", "EventError", 2, 929},
+	{"ZapSugar", "EventError", 3, 945},
+	{"Slog", "EventError", 0, 0},
+	{"SlogZap", "EventError", 0, 0},
+	{"Apex", "EventError", 5, 240},
+	{"Logrus", "EventError", 23, 1115},
+	{"Log15", "EventError", 20, 1432},
+	{"Logf", "EventError", 0, 0},
+
+	{"Zerolog", "EventCtx", 12, 552},
+	{"Phuslog", "EventCtx", 12, 552},
+	{"Zap", "EventCtx", 15, 1176},
+	{"ZapSugar", "EventCtx", 21, 1856},
+	{"Slog", "EventCtx", 28, 2385},
+	{"SlogZap", "EventCtx", 19, 1768},
+	{"Apex", "EventCtx", 52, 3580},
+	{"Logrus", "EventCtx", 67, 5503},
+	{"Log15", "EventCtx", 63, 5295},
+	{"Logf", "EventCtx", 88, 2512},
+
+	{"Zerolog", "EventCtxWarn", 12, 552},
+	{"Phuslog", "EventCtxWarn", 12, 553},
+	{"Zap", "EventCtxWarn", 15, 1176},
+	{"ZapSugar", "EventCtxWarn", 21, 1856},
+	{"Slog", "EventCtxWarn", 28, 2385},
+	{"SlogZap", "EventCtxWarn", 19, 1768},
+	{"Apex", "EventCtxWarn", 52, 3580},
+	{"Logrus", "EventCtxWarn", 67, 5510},
+	{"Log15", "EventCtxWarn", 63, 5294},
+	{"Logf", "EventCtxWarn", 88, 2512},
+
+	{"Zerolog", "EventCtxError", 12, 552},
+	{"Phuslog", "EventCtxError", 12, 553},
+	{"Zap", "EventCtxError", 17, 2105},
+	{"ZapSugar", "EventCtxError", 23, 2785},
+	{"Slog", "EventCtxError", 28, 2385},
+	{"SlogZap", "EventCtxError", 19, 1768},
+	{"Apex", "EventCtxError", 52, 3580},
+	{"Logrus", "EventCtxError", 67, 5511},
+	{"Log15", "EventCtxError", 63, 5297},
+	{"Logf", "EventCtxError", 88, 2512},
+
+	{"Zerolog", "DisabledCtx", 2, 72},
+	{"Phuslog", "DisabledCtx", 2, 72},
+	{"Zap", "DisabledCtx", 5, 696},
+	{"ZapSugar", "DisabledCtx", 8, 176},
+	{"Slog", "DisabledCtx", 5, 504},
+	{"SlogZap", "DisabledCtx", 5, 504},
+	{"Apex", "DisabledCtx", 12, 921},
+	{"Logrus", "DisabledCtx", 14, 1555},
+	{"Log15", "DisabledCtx", 13, 1208},
+	{"Logf", "DisabledCtx", 8, 176},
+
+	{"Zerolog", "DisabledCtxGuarded", 0, 0},
+	{"Phuslog", "DisabledCtxGuarded", 0, 0},
+	{"Zap", "DisabledCtxGuarded", 0, 0},
+	{"ZapSugar", "DisabledCtxGuarded", 0, 0},
+	{"Slog", "DisabledCtxGuarded", 0, 0},
+	{"SlogZap", "DisabledCtxGuarded", 0, 0},
+	{"Apex", "DisabledCtxGuarded", 0, 0},
+	{"Logrus", "DisabledCtxGuarded", 0, 0},
+	{"Log15", "DisabledCtxGuarded", 13, 1208},
+	{"Logf", "DisabledCtxGuarded", 0, 0},
+
+	{"Zerolog", "EventCtxWeak", 19, 1297},
+	{"Phuslog", "EventCtxWeak", 19, 1234},
+	{"Zap", "EventCtxWeak", 21, 1856},
+	{"ZapSugar", "EventCtxWeak", 21, 1856},
+	{"Slog", "EventCtxWeak", 32, 2265},
+	{"SlogZap", "EventCtxWeak", 23, 1648},
+	{"Apex", "EventCtxWeak", 53, 3595},
+	{"Logrus", "EventCtxWeak", 67, 5502},
+	{"Log15", "EventCtxWeak", 66, 5640},
+	{"Logf", "EventCtxWeak", 168, 4849},
+
+	{"Zerolog", "DisabledCtxWeak", 8, 176},
+	{"Phuslog", "DisabledCtxWeak", 9, 755},
+	{"Zap", "DisabledCtxWeak", 8, 176},
+	{"ZapSugar", "DisabledCtxWeak", 8, 176},
+	{"Slog", "DisabledCtxWeak", 8, 176},
+	{"SlogZap", "DisabledCtxWeak", 8, 176},
+	{"Apex", "DisabledCtxWeak", 13, 938},
+	{"Logrus", "DisabledCtxWeak", 14, 1555},
+	{"Log15", "DisabledCtxWeak", 13, 1496},
+	{"Logf", "DisabledCtxWeak", 8, 176},
+
+	{"Zerolog", "EventAccumulatedCtx", 0, 0},
+	{"Phuslog", "EventAccumulatedCtx", 0, 0},
+	{"Zap", "EventAccumulatedCtx", 0, 0},
+	{"ZapSugar", "EventAccumulatedCtx", 1, 16},
+	{"Slog", "EventAccumulatedCtx", 0, 0},
+	{"SlogZap", "EventAccumulatedCtx", 0, 0},
+	{"Apex", "EventAccumulatedCtx", 40, 2659},
+	{"Logrus", "EventAccumulatedCtx", 23, 1113},
+	{"Log15", "EventAccumulatedCtx", 54, 4829},
+	{"Logf", "EventAccumulatedCtx", 80, 2336},
+
+	{"Zerolog", "DisabledAccumulatedCtx", 0, 0},
+	{"Phuslog", "DisabledAccumulatedCtx", 0, 0},
+	{"Zap", "DisabledAccumulatedCtx", 0, 0},
+	{"ZapSugar", "DisabledAccumulatedCtx", 1, 16},
+	{"Slog", "DisabledAccumulatedCtx", 0, 0},
+	{"SlogZap", "DisabledAccumulatedCtx", 0, 0},
+	{"Apex", "DisabledAccumulatedCtx", 0, 0},
+	{"Logrus", "DisabledAccumulatedCtx", 1, 16},
+	{"Log15", "DisabledAccumulatedCtx", 4, 744},
+	{"Logf", "DisabledAccumulatedCtx", 0, 0},
+
+	{"Zerolog", "EventFromContext", 0, 0},
+	{"Phuslog", "EventFromContext", 0, 0},
+	{"Zap", "EventFromContext", 0, 0},
+	{"ZapSugar", "EventFromContext", 1, 16},
+	{"Slog", "EventFromContext", 0, 0},
+	{"SlogZap", "EventFromContext", 1, 128},
+	{"Apex", "EventFromContext", 13, 832},
+	{"Logrus", "EventFromContext", 27, 1544},
+	{"Log15", "EventFromContext", 25, 1774},
+	{"Logf", "EventFromContext", 0, 0},
+
+	{"Zerolog", "EventLazy", 3, 176},
+	{"Phuslog", "EventLazy", 3, 176},
+	{"Zap", "EventLazy", 4, 240},
+	{"ZapSugar", "EventLazy", 4, 304},
+	{"Slog", "EventLazy", 4, 224},
+	{"SlogZap", "EventLazy", 5, 288},
+	{"Apex", "EventLazy", 18, 1352},
+	{"Logrus", "EventLazy", 34, 2217},
+	{"Log15", "EventLazy", 30, 1905},
+	{"Logf", "EventLazy", 3, 176},
+
+	{"Zerolog", "DisabledLazy", 0, 0},
+	{"Phuslog", "DisabledLazy", 0, 0},
+	{"Zap", "DisabledLazy", 1, 64},
+	{"ZapSugar", "DisabledLazy", 0, 0},
+	{"Slog", "DisabledLazy", 1, 48},
+	{"SlogZap", "DisabledLazy", 1, 48},
+	{"Apex", "DisabledLazy", 4, 456},
+	{"Logrus", "DisabledLazy", 5, 512},
+	{"Log15", "DisabledLazy", 6, 536},
+	{"Logf", "DisabledLazy", 0, 0},
+
+	{"Zerolog", "EventChildLogger", 1, 512},
+	{"Phuslog", "EventChildLogger", 5, 312},
+	{"Zap", "EventChildLogger", 6, 1536},
+	{"ZapSugar", "EventChildLogger", 14, 1912},
+	{"Slog", "EventChildLogger", 14, 864},
+	{"SlogZap", "EventChildLogger", 14, 1968},
+	{"Apex", "EventChildLogger", 59, 3593},
+	{"Logrus", "EventChildLogger", 101, 5769},
+	{"Log15", "EventChildLogger", 96, 6601},
+	{"Logf", "EventChildLogger", 5, 192},
+
+	{"Zerolog", "EventGroups", 0, 0},
+	{"Phuslog", "EventGroups", 14, 448},
+	{"Zap", "EventGroups", 1, 256},
+	{"ZapSugar", "EventGroups", 6, 576},
+	{"Slog", "EventGroups", 15, 848},
+	{"SlogZap", "EventGroups", 20, 1072},
+	{"Apex", "EventGroups", 56, 3785},
+	{"Logrus", "EventGroups", 70, 4537},
+	{"Log15", "EventGroups", 65, 4377},
+	{"Logf", "EventGroups", 3, 48},
+
+	{"Zerolog", "EventAccumulatedGroups", 0, 0},
+	{"Phuslog", "EventAccumulatedGroups", 0, 0},
+	{"Zap", "EventAccumulatedGroups", 0, 0},
+	{"ZapSugar", "EventAccumulatedGroups", 1, 16},
+	{"Slog", "EventAccumulatedGroups", 0, 0},
+	{"SlogZap", "EventAccumulatedGroups", 0, 0},
+	{"Apex", "EventAccumulatedGroups", 41, 1936},
+	{"Logrus", "EventAccumulatedGroups", 55, 2648},
+	{"Log15", "EventAccumulatedGroups", 53, 2921},
+	{"Logf", "EventAccumulatedGroups", 0, 0},
+
+	{"Zerolog", "EventLargeMsg", 0, 0},
+	{"Phuslog", "EventLargeMsg", 0, 0},
+	{"Zap", "EventLargeMsg", 0, 0},
+	{"ZapSugar", "EventLargeMsg", 0, 0},
+	{"Slog", "EventLargeMsg", 0, 0},
+	{"SlogZap", "EventLargeMsg", 0, 0},
+	{"Apex", "EventLargeMsg", 8, 408},
+	{"Logrus", "EventLargeMsg", 25, 5321},
+	{"Log15", "EventLargeMsg", 20, 6185},
+	{"Logf", "EventLargeMsg", 0, 0},
+
+	{"Zerolog", "EventHugeMsg", 3, 74356},
+	{"Phuslog", "EventHugeMsg", 3, 74804},
+	{"Zap", "EventHugeMsg", 0, 0},
+	{"ZapSugar", "EventHugeMsg", 0, 0},
+	{"Slog", "EventHugeMsg", 3, 74780},
+	{"SlogZap", "EventHugeMsg", 0, 0},
+	{"Apex", "EventHugeMsg", 8, 408},
+	{"Logrus", "EventHugeMsg", 25, 66774},
+	{"Log15", "EventHugeMsg", 20, 75055},
+	{"Logf", "EventHugeMsg", 0, 0},
+
+	{"Zerolog", "EventManyFields", 0, 0},
+	{"Phuslog", "EventManyFields", 0, 0},
+	{"Zap", "EventManyFields", 1, 13570},
+	{"ZapSugar", "EventManyFields", 1, 13570},
+	{"Slog", "EventManyFields", 2, 12290},
+	{"SlogZap", "EventManyFields", 3, 18819},
+	{"Apex", "EventManyFields", 223, 39346},
+	{"Logrus", "EventManyFields", 232, 48771},
+	{"Log15", "EventManyFields", 230, 35669},
+	{"Logf", "EventManyFields", 0, 0},
+
+	{"Zerolog", "EventNestedMap", 99, 4529},
+	{"Phuslog", "EventNestedMap", 98, 4145},
+	{"Zap", "EventNestedMap", 100, 4369},
+	{"ZapSugar", "EventNestedMap", 100, 4369},
+	{"Slog", "EventNestedMap", 100, 4577},
+	{"SlogZap", "EventNestedMap", 100, 4305},
+	{"Apex", "EventNestedMap", 111, 5225},
+	{"Logrus", "EventNestedMap", 127, 6090},
+	{"Log15", "EventNestedMap", 121, 6105},
+	{"Logf", "EventNestedMap", 89, 3297},
+
+	{"Zerolog", "EventEscaping", 0, 0},
+	{"Phuslog", "EventEscaping", 0, 0},
+	{"Zap", "EventEscaping", 1, 768},
+	{"ZapSugar", "EventEscaping", 1, 768},
+	{"Slog", "EventEscaping", 1, 48},
+	{"SlogZap", "EventEscaping", 2, 432},
+	{"Apex", "EventEscaping", 25, 1672},
+	{"Logrus", "EventEscaping", 39, 2747},
+	{"Log15", "EventEscaping", 34, 3180},
+	{"Logf", "EventEscaping", 0, 0},
+
+	{"Zerolog", "FieldInt", 0, 0},
+	{"Phuslog", "FieldInt", 0, 0},
+	{"Zap", "FieldInt", 1, 64},
+	{"ZapSugar", "FieldInt", 3, 152},
+	{"Slog", "FieldInt", 0, 0},
+	{"SlogZap", "FieldInt", 1, 64},
+	{"Apex", "FieldInt", 14, 1088},
+	{"Logrus", "FieldInt", 30, 1953},
+	{"Log15", "FieldInt", 26, 1632},
+	{"Logf", "FieldInt", 2, 24},
+
+	{"Zerolog", "FieldString", 0, 0},
+	{"Phuslog", "FieldString", 0, 0},
+	{"Zap", "FieldString", 1, 64},
+	{"ZapSugar", "FieldString", 3, 160},
+	{"Slog", "FieldString", 0, 0},
+	{"SlogZap", "FieldString", 1, 64},
+	{"Apex", "FieldString", 14, 1096},
+	{"Logrus", "FieldString", 30, 1961},
+	{"Log15", "FieldString", 26, 1672},
+	{"Logf", "FieldString", 2, 32},
+
+	{"Zerolog", "FieldFloat64", 0, 0},
+	{"Phuslog", "FieldFloat64", 0, 0},
+	{"Zap", "FieldFloat64", 1, 64},
+	{"ZapSugar", "FieldFloat64", 3, 152},
+	{"Slog", "FieldFloat64", 3, 120},
+	{"SlogZap", "FieldFloat64", 1, 64},
+	{"Apex", "FieldFloat64", 14, 1088},
+	{"Logrus", "FieldFloat64", 30, 1953},
+	{"Log15", "FieldFloat64", 26, 1649},
+	{"Logf", "FieldFloat64", 2, 24},
+
+	{"Zerolog", "FieldBool", 0, 0},
+	{"Phuslog", "FieldBool", 0, 0},
+	{"Zap", "FieldBool", 1, 64},
+	{"ZapSugar", "FieldBool", 2, 144},
+	{"Slog", "FieldBool", 0, 0},
+	{"SlogZap", "FieldBool", 1, 64},
+	{"Apex", "FieldBool", 13, 1080},
+	{"Logrus", "FieldBool", 29, 1945},
+	{"Log15", "FieldBool", 25, 1624},
+	{"Logf", "FieldBool", 1, 16},
+
+	{"Zerolog", "FieldTime", 0, 0},
+	{"Phuslog", "FieldTime", 0, 0},
+	{"Zap", "FieldTime", 1, 64},
+	{"ZapSugar", "FieldTime", 3, 168},
+	{"Slog", "FieldTime", 0, 0},
+	{"SlogZap", "FieldTime", 1, 64},
+	{"Apex", "FieldTime", 15, 1152},
+	{"Logrus", "FieldTime", 31, 2017},
+	{"Log15", "FieldTime", 28, 1704},
+	{"Logf", "FieldTime", 5, 168},
+
+	{"Zerolog", "FieldDuration", 0, 0},
+	{"Phuslog", "FieldDuration", 0, 0},
+	{"Zap", "FieldDuration", 1, 64},
+	{"ZapSugar", "FieldDuration", 3, 152},
+	{"Slog", "FieldDuration", 0, 0},
+	{"SlogZap", "FieldDuration", 1, 64},
+	{"Apex", "FieldDuration", 14, 1088},
+	{"Logrus", "FieldDuration", 30, 1953},
+	{"Log15", "FieldDuration", 28, 1656},
+	{"Logf", "FieldDuration", 3, 32},
+
+	{"Zerolog", "FieldError", 0, 0},
+	{"Phuslog", "FieldError", 0, 0},
+	{"Zap", "FieldError", 1, 64},
+	{"ZapSugar", "FieldError", 2, 144},
+	{"Slog", "FieldError", 0, 0},
+	{"SlogZap", "FieldError", 1, 64},
+	{"Apex", "FieldError", 13, 1080},
+	{"Logrus", "FieldError", 30, 1961},
+	{"Log15", "FieldError", 26, 1672},
+	{"Logf", "FieldError", 1, 16},
+
+	{"Zerolog", "FieldStrings", 0, 0},
+	{"Phuslog", "FieldStrings", 0, 0},
+	{"Zap", "FieldStrings", 2, 88},
+	{"ZapSugar", "FieldStrings", 4, 192},
+	{"Slog", "FieldStrings", 3, 184},
+	{"SlogZap", "FieldStrings", 3, 112},
+	{"Apex", "FieldStrings", 14, 1104},
+	{"Logrus", "FieldStrings", 30, 1969},
+	{"Log15", "FieldStrings", 26, 2157},
+	{"Logf", "FieldStrings", 15, 328},
+
+	{"Zerolog", "FieldInts", 0, 0},
+	{"Phuslog", "FieldInts", 0, 0},
+	{"Zap", "FieldInts", 2, 88},
+	{"ZapSugar", "FieldInts", 4, 192},
+	{"Slog", "FieldInts", 3, 136},
+	{"SlogZap", "FieldInts", 3, 112},
+	{"Apex", "FieldInts", 14, 1104},
+	{"Logrus", "FieldInts", 30, 1969},
+	{"Log15", "FieldInts", 26, 1664},
+	{"Logf", "FieldInts", 13, 152},
+
+	{"Zerolog", "FieldObject", 1, 48},
+	{"Phuslog", "FieldObject", 1, 48},
+	{"Zap", "FieldObject", 2, 112},
+	{"ZapSugar", "FieldObject", 3, 192},
+	{"Slog", "FieldObject", 2, 176},
+	{"SlogZap", "FieldObject", 4, 264},
+
+	{"Zerolog", "FieldObjectReflect", 3, 160},
+	{"Phuslog", "FieldObjectReflect", 2, 96},
+	{"Zap", "FieldObjectReflect", 4, 256},
+	{"ZapSugar", "FieldObjectReflect", 5, 336},
+	{"Slog", "FieldObjectReflect", 4, 209},
+	{"SlogZap", "FieldObjectReflect", 4, 256},
+	{"Apex", "FieldObjectReflect", 15, 1176},
+	{"Logrus", "FieldObjectReflect", 31, 2041},
+	{"Log15", "FieldObjectReflect", 27, 1768},
+	{"Logf", "FieldObjectReflect", 4, 144},
+
+	{"Zerolog", "FieldObjects", 11, 504},
+	{"Zap", "FieldObjects", 12, 568},
+	{"ZapSugar", "FieldObjects", 13, 648},
+
+	{"Zerolog", "FieldObjectsReflect", 12, 1144},
+	{"Phuslog", "FieldObjectsReflect", 11, 505},
+	{"Zap", "FieldObjectsReflect", 13, 664},
+	{"ZapSugar", "FieldObjectsReflect", 14, 745},
+	{"Slog", "FieldObjectsReflect", 13, 1193},
+	{"SlogZap", "FieldObjectsReflect", 13, 665},
+	{"Apex", "FieldObjectsReflect", 24, 1584},
+	{"Logrus", "FieldObjectsReflect", 40, 2449},
+	{"Log15", "FieldObjectsReflect", 36, 2704},
+	{"Logf", "FieldObjectsReflect", 53, 1768},
+
+	{"Zerolog", "FieldMap", 11, 488},
+	{"Phuslog", "FieldMap", 10, 424},
+	{"Zap", "FieldMap", 12, 585},
+	{"ZapSugar", "FieldMap", 13, 665},
+	{"Slog", "FieldMap", 12, 536},
+	{"SlogZap", "FieldMap", 12, 585},
+	{"Apex", "FieldMap", 23, 1504},
+	{"Logrus", "FieldMap", 39, 2369},
+	{"Log15", "FieldMap", 35, 2096},
+	{"Logf", "FieldMap", 11, 384},
+}
+
+// bytesSlack and bytesSlackRatio absorb the noise in the average bytes per
+// operation: background allocations by the runtime, and buffers that some
+// libraries grow differently from one run to the next. The larger of the two
+// is allowed. bytesSlack is smaller than the smallest size class, so a budget
+// of zero still catches a single allocation, while allocs/op has no slack and
+// catches one in the larger budgets.
+const (
+	bytesSlack      = 8
+	bytesSlackRatio = 0.05
+)
+
+// budgetScenario is a scenario covered by TestAllocBudgets. prepare creates
+// the logger of the scenario for a library and returns the operation that
+// its benchmark times.
+type budgetScenario struct {
+	name    string
+	prepare func(v logBenchmark, w io.Writer) func()
+}
+
+// budgetScenarios returns the core scenarios followed by those of the other
+// benchmarks that repeat a single operation, with the default payload sizes.
+// The remaining benchmarks are not covered: BenchmarkMixedWorkload mixes the
+// operations of covered scenarios, BenchmarkLevelSwitch logs the Disabled
+// event, BenchmarkLoad and BenchmarkGCImpact run the core scenarios, and the
+// logger construction of BenchmarkNewLogger is not a logging call.
+func budgetScenarios() []budgetScenario {
+	var all []budgetScenario
+
+	for _, s := range scenarios {
+		s := s
+
+		all = append(all, budgetScenario{s.name, func(v logBenchmark, w io.Writer) func() {
+			l := s.new(v, w)
+			return func() { s.log(l) }
+		}})
+	}
+
+	all = append(all,
+		budgetScenario{"EventFromContext", func(v logBenchmark, w io.Writer) func() {
+			l := v.new(w)
+			ctx := l.withTraceContext(context.Background())
+
+			return func() { l.logEventFromContext(ctx, logMsg) }
+		}},
+		budgetScenario{"EventLazy", logOp(logBenchmark.logEventLazy)},
+		budgetScenario{"DisabledLazy", logOp(logBenchmark.logDisabledLazy)},
+		budgetScenario{"EventChildLogger", logOp(logBenchmark.logRequest)},
+		budgetScenario{"EventGroups", logOp(logBenchmark.logEventGroups)},
+		budgetScenario{"EventAccumulatedGroups", func(v logBenchmark, w io.Writer) func() {
+			l := v.newWithGroups(w)
+			return func() { l.logEvent(logMsg) }
+		}},
+	)
+
+	for _, p := range payloads() {
+		p := p
+
+		all = append(all, budgetScenario{p.scenario, func(v logBenchmark, w io.Writer) func() {
+			l := v.new(w)
+			return func() { l.logEventKV(p.msg, p.keysAndValues...) }
+		}})
+	}
+
+	for kind := fieldKind(0); kind < numFieldKinds; kind++ {
+		kind := kind
+
+		all = append(all, budgetScenario{"Field" + kind.String(), func(v logBenchmark, w io.Writer) func() {
+			if !hasFieldAPI(v, kind) {
+				return nil
+			}
+
+			l := v.new(w)
+
+			return func() { l.logEventField(logMsg, kind) }
+		}})
+	}
+
+	return all
+}
+
+// logOp returns the prepare function of a scenario that logs logMsg with log.
+func logOp(log func(l logBenchmark, msg string)) func(v logBenchmark, w io.Writer) func() {
+	return func(v logBenchmark, w io.Writer) func() {
+		l := v.new(w)
+		return func() { log(l, logMsg) }
+	}
+}
+
+// TestAllocBudgets checks the allocations and bytes allocated per operation
+// by every library in every scenario of budgetScenarios against
+// allocBudgets. Scenarios that a library skips have no budget.
+func TestAllocBudgets(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector allocates")
+	}
+
+	if v := runtime.Version(); v != budgetToolchain {
+		t.Skipf("the budgets were measured with %s, not %s", budgetToolchain, v)
+	}
+
+	budgets := make(map[[2]string]allocBudget, len(allocBudgets))
+	for _, b := range allocBudgets {
+		budgets[[2]string{b.library, b.scenario}] = b
+	}
+
+	for _, s := range budgetScenarios() {
+		t.Run(s.name, func(t *testing.T) {
+			for _, v := range loggers {
+				t.Run(v.name(), func(t *testing.T) {
+					op := s.prepare(v, &blackhole{})
+					if op == nil {
+						t.Skipf("%s skips the scenario", v.name())
+					}
+
+					allocs := testing.AllocsPerRun(100, op)
+					bytes := bytesPerRun(1000, op)
+
+					budget, ok := budgets[[2]string{v.name(), s.name}]
+					if !ok {
+						t.Fatalf(
+							"No allocation budget, measured: {%q, %q, %.0f, %.0f}",
+							v.name(), s.name, allocs, bytes,
+						)
+					}
+
+					slack := max(bytesSlack, budget.bytes*bytesSlackRatio)
+
+					if allocs > budget.allocs || bytes >= budget.bytes+slack {
+						t.Errorf(
+							"Over the allocation budget:\n"+
+								"  allocs/op: budget %.0f, actual %.0f\n"+
+								"  B/op:      budget %.0f, actual %.0f",
+							budget.allocs, allocs, budget.bytes, bytes,
+						)
+					}
+				})
+			}
+		})
+	}
+}
+
+// bytesPerRun returns the average number of bytes allocated by f over runs
+// calls, the way testing.AllocsPerRun counts allocations.
+func bytesPerRun(runs int, f func()) float64 {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+
+	// Warm up the function.
+	f()
+
+	var before, after runtime.MemStats
+
+	runtime.ReadMemStats(&before)
+
+	for i := 0; i < runs; i++ {
+		f()
+	}
+
+	runtime.ReadMemStats(&after)
+
+	return float64(after.TotalAlloc-before.TotalAlloc) / float64(runs)
+}
@@ -0,0 +1,46 @@
+// Command dashdata turns the results written by gobenchdata into the JSON
+// consumed by the dashboard in docs, so that the front end reads a stable
+// schema instead of parsing benchmark names.
+//
+// Usage:
+//
+//	go run ./cmd/dashdata -in bench.json -src . -out docs/data.json
+//
+// Scenario descriptions are taken from the b.Logf call at the top of each
+// Benchmark function in the *_test.go files under -src.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/betterstack-community/go-logging-benchmarks/internal/dashboard"
+)
+
+func main() {
+	in := flag.String("in", "bench.json", "results written by gobenchdata")
+	src := flag.String("src", ".", "directory holding the benchmark sources")
+	out := flag.String("out", "docs/data.json", "dashboard data to write")
+	flag.Parse()
+
+	if err := run(*in, *src, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "dashdata:", err)
+		os.Exit(1)
+	}
+}
+
+func run(in, src, out string) error {
+	d, err := dashboard.Load(in, src)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(out, append(b, '\n'), 0o644)
+}
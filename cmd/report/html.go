@@ -0,0 +1,135 @@
+package main
+
+import (
+	"html/template"
+	"io"
+)
+
+// Dimensions of the bar charts, in pixels.
+const (
+	chartLabelWidth = 90
+	chartBarWidth   = 420
+	chartValueWidth = 110
+	chartRowHeight  = 24
+	chartBarHeight  = 16
+)
+
+// bar is a single bar of a chart, positioned for the SVG template.
+type bar struct {
+	Y      int
+	TextY  int
+	Width  int
+	Height int
+	Label  string
+	Value  string
+	Winner bool
+}
+
+// chart is the inline SVG chart of a scenario, comparing ns/op.
+type chart struct {
+	Width  int
+	Height int
+	BarX   int
+	Bars   []bar
+}
+
+func newChart(ranks []rank) chart {
+	c := chart{
+		Width:  chartLabelWidth + chartBarWidth + chartValueWidth,
+		Height: len(ranks) * chartRowHeight,
+		BarX:   chartLabelWidth,
+	}
+
+	var slowest float64
+	for _, rk := range ranks {
+		slowest = max(slowest, rk.NsPerOp)
+	}
+
+	for i, rk := range ranks {
+		width := 1
+		if slowest > 0 {
+			width = max(width, int(rk.NsPerOp/slowest*chartBarWidth))
+		}
+
+		y := i * chartRowHeight
+		c.Bars = append(c.Bars, bar{
+			Y:      y + (chartRowHeight-chartBarHeight)/2,
+			TextY:  y + chartRowHeight/2 + 4,
+			Width:  width,
+			Height: chartBarHeight,
+			Label:  rk.Library,
+			Value:  formatFloat(rk.NsPerOp) + " ns/op",
+			Winner: rk.Position == 1,
+		})
+	}
+
+	return c
+}
+
+var htmlTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"chart":  newChart,
+	"format": formatFloat,
+	"add":    func(a, b int) int { return a + b },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Go Logging Benchmarks</title>
+<style>
+  body { font-family: system-ui, sans-serif; margin: 2rem auto; max-width: 960px; color: #1f2328; }
+  h2 { margin-top: 2.5rem; }
+  table { border-collapse: collapse; margin-top: 1rem; }
+  th, td { padding: 0.25rem 0.75rem; border-bottom: 1px solid #d0d7de; }
+  td.num { text-align: right; font-variant-numeric: tabular-nums; }
+  tr.winner { font-weight: bold; }
+  svg text { font-size: 12px; }
+  .bar { fill: #8aa4c8; }
+  .bar.winner { fill: #2e7d32; }
+</style>
+</head>
+<body>
+<h1>Go Logging Benchmarks</h1>
+<p>
+  {{- if not .Date.IsZero}}Results from {{.Date.Format "2006-01-02"}}, {{end -}}
+  GOMAXPROCS={{.Procs}}.
+  {{- if .Env}} Measured with {{.Env}}.{{end}}
+</p>
+{{range .Scenarios}}
+<h2 id="{{.Name}}">{{.Name}}</h2>
+{{if .Description}}<p>{{.Description}}.</p>{{end}}
+{{with chart .Ranks -}}
+<svg xmlns="http://www.w3.org/2000/svg" width="{{.Width}}" height="{{.Height}}" viewBox="0 0 {{.Width}} {{.Height}}" role="img">
+{{- $x := .BarX}}
+{{- range .Bars}}
+  <text x="0" y="{{.TextY}}">{{.Label}}</text>
+  <rect class="bar{{if .Winner}} winner{{end}}" x="{{$x}}" y="{{.Y}}" width="{{.Width}}" height="{{.Height}}"></rect>
+  <text x="{{add $x .Width | add 6}}" y="{{.TextY}}">{{.Value}}</text>
+{{- end}}
+</svg>
+{{- end}}
+<table>
+  <thead>
+    <tr><th>Rank</th><th>Library</th><th>ns/op</th><th>B/op</th><th>allocs/op</th></tr>
+  </thead>
+  <tbody>
+  {{- range .Ranks}}
+    <tr{{if eq .Position 1}} class="winner"{{end}}>
+      <td class="num">{{.Position}}</td>
+      <td>{{.Library}}</td>
+      <td class="num">{{format .NsPerOp}}</td>
+      <td class="num">{{format .BytesPerOp}}</td>
+      <td class="num">{{format .AllocsPerOp}}</td>
+    </tr>
+  {{- end}}
+  </tbody>
+</table>
+{{end}}
+</body>
+</html>
+`))
+
+// writeHTML writes a standalone page with a chart and a ranking table for
+// every scenario.
+func (r report) writeHTML(w io.Writer) error {
+	return htmlTemplate.Execute(w, r)
+}
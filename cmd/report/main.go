@@ -0,0 +1,169 @@
+// Command report renders the results written by gobenchdata as a standalone
+// HTML page, with inline SVG charts and a ranking of the libraries in every
+// scenario, and as a Markdown table for the README. Neither output needs the
+// Node toolchain used to build the dashboard in docs.
+//
+// Usage:
+//
+//	go run ./cmd/report -in bench.json -src . -html report.html -md report.md
+//
+// Only the latest run is reported, at the highest GOMAXPROCS value it has
+// results for. Modes with their own metrics, such as GCImpact, are left out.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/betterstack-community/go-logging-benchmarks/internal/dashboard"
+)
+
+// report is the data shared by the HTML and Markdown outputs.
+type report struct {
+	Date      time.Time
+	Procs     int
+	Env       string
+	Libraries []string
+	Scenarios []scenarioReport
+}
+
+type scenarioReport struct {
+	dashboard.Scenario
+	// Ranks are sorted from fastest to slowest.
+	Ranks []rank
+}
+
+type rank struct {
+	Position    int
+	Library     string
+	NsPerOp     float64
+	BytesPerOp  float64
+	AllocsPerOp float64
+}
+
+func main() {
+	in := flag.String("in", "bench.json", "results written by gobenchdata")
+	src := flag.String("src", ".", "directory holding the benchmark sources")
+	htmlOut := flag.String("html", "report.html", "HTML report to write, or empty to skip it")
+	mdOut := flag.String("md", "report.md", "Markdown table to write, or empty to skip it")
+	flag.Parse()
+
+	if err := run(*in, *src, *htmlOut, *mdOut); err != nil {
+		fmt.Fprintln(os.Stderr, "report:", err)
+		os.Exit(1)
+	}
+}
+
+func run(in, src, htmlOut, mdOut string) error {
+	d, err := dashboard.Load(in, src)
+	if err != nil {
+		return err
+	}
+
+	r, err := newReport(d)
+	if err != nil {
+		return err
+	}
+
+	if htmlOut != "" {
+		if err := writeFile(htmlOut, r.writeHTML); err != nil {
+			return err
+		}
+	}
+
+	if mdOut != "" {
+		if err := writeFile(mdOut, r.writeMarkdown); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func newReport(d dashboard.Dashboard) (report, error) {
+	if len(d.Runs) == 0 {
+		return report{}, fmt.Errorf("no runs to report")
+	}
+
+	run := d.Runs[0]
+	r := report{Procs: run.MaxProcs(), Env: describeEnv(run)}
+
+	if run.Date != 0 {
+		r.Date = time.Unix(run.Date, 0).UTC()
+	}
+
+	for _, l := range d.Libraries {
+		r.Libraries = append(r.Libraries, l.Name)
+	}
+
+	for _, s := range d.Scenarios {
+		if s.Name != s.Benchmark {
+			continue
+		}
+
+		sr := scenarioReport{Scenario: s}
+
+		for _, res := range run.ScenarioResults(s.Name, r.Procs) {
+			sr.Ranks = append(sr.Ranks, rank{
+				Library:     res.Library,
+				NsPerOp:     res.Metrics["ns/op"],
+				BytesPerOp:  res.Metrics["B/op"],
+				AllocsPerOp: res.Metrics["allocs/op"],
+			})
+		}
+
+		if len(sr.Ranks) == 0 {
+			continue
+		}
+
+		sort.SliceStable(sr.Ranks, func(i, j int) bool {
+			return sr.Ranks[i].NsPerOp < sr.Ranks[j].NsPerOp
+		})
+
+		for i := range sr.Ranks {
+			sr.Ranks[i].Position = i + 1
+			if i > 0 && sr.Ranks[i].NsPerOp == sr.Ranks[i-1].NsPerOp {
+				sr.Ranks[i].Position = sr.Ranks[i-1].Position
+			}
+		}
+
+		r.Scenarios = append(r.Scenarios, sr)
+	}
+
+	return r, nil
+}
+
+// describeEnv summarizes the environment recorded with -env-file.
+func describeEnv(run dashboard.Run) string {
+	if len(run.Env) == 0 {
+		return ""
+	}
+
+	env, err := run.Environment()
+	if err != nil {
+		return ""
+	}
+
+	return fmt.Sprintf(
+		"%s on %s (%d cores, GOMAXPROCS=%d, %s/%s, kernel %s)",
+		env.GoVersion, env.CPU, env.NumCPU, env.GOMAXPROCS, env.Goos, env.Goarch, env.Kernel,
+	)
+}
+
+func writeFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+
+	return f.Close()
+}
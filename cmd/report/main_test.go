@@ -0,0 +1,62 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/betterstack-community/go-logging-benchmarks/internal/dashboard"
+)
+
+func TestNewReportRanks(t *testing.T) {
+	result := func(library string, ns float64) dashboard.Result {
+		return dashboard.Result{
+			Scenario: "Event",
+			Library:  library,
+			Procs:    4,
+			Metrics:  map[string]float64{"ns/op": ns},
+		}
+	}
+
+	d := dashboard.Dashboard{
+		Scenarios: []dashboard.Scenario{
+			{Name: "Event", Benchmark: "Event"},
+			{Name: "GCImpact/Event", Benchmark: "GCImpact"},
+		},
+		Runs: []dashboard.Run{{Results: []dashboard.Result{
+			result("Zap", 200),
+			result("Slog", 100),
+			result("Logf", 200),
+			{Scenario: "Event", Library: "Slog", Procs: 1, Metrics: map[string]float64{"ns/op": 50}},
+			{Scenario: "GCImpact/Event", Library: "Zap", Procs: 4},
+		}}},
+	}
+
+	r, err := newReport(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Procs != 4 {
+		t.Errorf("expected GOMAXPROCS 4, got %d", r.Procs)
+	}
+
+	if len(r.Scenarios) != 1 {
+		t.Fatalf("expected only the Event scenario, got %d scenarios", len(r.Scenarios))
+	}
+
+	want := []rank{
+		{Position: 1, Library: "Slog", NsPerOp: 100},
+		{Position: 2, Library: "Zap", NsPerOp: 200},
+		{Position: 2, Library: "Logf", NsPerOp: 200},
+	}
+
+	got := r.Scenarios[0].Ranks
+	if len(got) != len(want) {
+		t.Fatalf("expected %d ranks, got %d", len(want), len(got))
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("rank %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// writeMarkdown writes a table with a row per scenario and a column per
+// library. Every cell holds ns/op followed by allocs/op, and the fastest
+// library of each scenario is in bold.
+func (r report) writeMarkdown(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "| Scenario | %s |\n", strings.Join(r.Libraries, " | "))
+	fmt.Fprintf(bw, "| --- |%s\n", strings.Repeat(" ---: |", len(r.Libraries)))
+
+	for _, s := range r.Scenarios {
+		cells := make([]string, len(r.Libraries))
+		for i := range cells {
+			cells[i] = "n/a"
+		}
+
+		for _, rk := range s.Ranks {
+			i := indexOf(r.Libraries, rk.Library)
+			if i == -1 {
+				continue
+			}
+
+			cells[i] = fmt.Sprintf("%s ns (%s allocs)", formatFloat(rk.NsPerOp), formatFloat(rk.AllocsPerOp))
+			if rk.Position == 1 {
+				cells[i] = "**" + cells[i] + "**"
+			}
+		}
+
+		fmt.Fprintf(bw, "| %s | %s |\n", s.Name, strings.Join(cells, " | "))
+	}
+
+	if r.Env != "" {
+		fmt.Fprintf(bw, "\n_Measured with %s._\n", r.Env)
+	}
+
+	return bw.Flush()
+}
+
+func indexOf(s []string, v string) int {
+	for i := range s {
+		if s[i] == v {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// formatFloat formats v with fewer decimals as it grows, without trailing
+// zeros.
+func formatFloat(v float64) string {
+	prec := 2
+	switch {
+	case v >= 100:
+		prec = 0
+	case v >= 10:
+		prec = 1
+	}
+
+	s := strconv.FormatFloat(v, 'f', prec, 64)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	return s
+}
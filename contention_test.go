@@ -0,0 +1,110 @@
+package bench
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var contention = flag.Bool(
+	"contention",
+	false,
+	"report the time spent on contended mutexes and blocking per logged event",
+)
+
+// parallelWorkerFrame identifies the stack frames of the goroutines started by
+// b.RunParallel, so that waiting done by the benchmark itself is ignored.
+const parallelWorkerFrame = "testing.(*B).RunParallel.func1"
+
+// contentionMeter enables the mutex and block profiles for the duration of a
+// parallel sub-benchmark and reports the contention recorded by its workers.
+type contentionMeter struct {
+	mutexFraction int
+	mutexBefore   float64
+	blockBefore   float64
+}
+
+func startContention() *contentionMeter {
+	c := &contentionMeter{
+		mutexFraction: runtime.SetMutexProfileFraction(1),
+	}
+
+	runtime.SetBlockProfileRate(1)
+
+	c.mutexBefore = contendedNs("mutex")
+	c.blockBefore = contendedNs("block")
+
+	return c
+}
+
+// stop reports the mutex-ns/event and block-ns/event metrics: the
+// nanoseconds the workers spent waiting on a contended mutex, and blocked on
+// any synchronization primitive, for each of the eventsPerOp events logged
+// by every operation.
+func (c *contentionMeter) stop(b *testing.B, eventsPerOp int) {
+	mutex := contendedNs("mutex") - c.mutexBefore
+	block := contendedNs("block") - c.blockBefore
+
+	runtime.SetBlockProfileRate(0)
+	runtime.SetMutexProfileFraction(c.mutexFraction)
+
+	events := float64(b.N) * float64(eventsPerOp)
+
+	b.ReportMetric(mutex/events, "mutex-ns/event")
+	b.ReportMetric(block/events, "block-ns/event")
+}
+
+// contendedNs returns the total delay recorded so far in the named contention
+// profile for stacks that run in a parallel benchmark worker. It reads the
+// legacy text format, which carries the cycles/second rate needed to convert
+// the recorded cycles to nanoseconds.
+func contendedNs(name string) float64 {
+	var buf bytes.Buffer
+	if err := pprof.Lookup(name).WriteTo(&buf, 1); err != nil {
+		return 0
+	}
+
+	var (
+		cyclesPerNs float64
+		cycles      float64
+		recCycles   float64
+		inWorker    bool
+	)
+
+	flush := func() {
+		if inWorker {
+			cycles += recCycles
+		}
+
+		recCycles, inWorker = 0, false
+	}
+
+	sc := bufio.NewScanner(&buf)
+	for sc.Scan() {
+		line := sc.Text()
+
+		switch {
+		case strings.HasPrefix(line, "cycles/second="):
+			cps, _ := strconv.ParseFloat(strings.TrimPrefix(line, "cycles/second="), 64)
+			cyclesPerNs = cps / 1e9
+		case strings.Contains(line, " @ "):
+			flush()
+			recCycles, _ = strconv.ParseFloat(strings.Fields(line)[0], 64)
+		case strings.HasPrefix(line, "#") && strings.Contains(line, parallelWorkerFrame):
+			inWorker = true
+		}
+	}
+
+	flush()
+
+	if cyclesPerNs == 0 {
+		return 0
+	}
+
+	return cycles / cyclesPerNs
+}
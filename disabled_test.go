@@ -0,0 +1,89 @@
+package bench
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// allocFreeDisabled lists the libraries expected to log at a disabled level
+// without allocating, for every disabled scenario that has any. The
+// DisabledCtx and DisabledCtxWeak scenarios are missing because the fields
+// are built before the level is checked, whatever the library. Log15 cannot
+// check the level first, so it is missing from DisabledCtxGuarded.
+var allocFreeDisabled = map[string][]string{
+	"Disabled": {
+		"Zerolog", "Phuslog", "Zap", "Slog", "SlogZap", "Apex", "Logf",
+	},
+	"DisabledCtxGuarded": {
+		"Zerolog", "Phuslog", "Zap", "ZapSugar", "Slog", "SlogZap", "Apex", "Logrus", "Logf",
+	},
+	"DisabledFmt": {
+		"Zerolog", "Phuslog", "ZapSugar", "Logrus",
+	},
+	"DisabledAccumulatedCtx": {
+		"Zerolog", "Phuslog", "Zap", "Slog", "SlogZap", "Apex", "Logf",
+	},
+}
+
+// disabledScenarios returns the scenarios that log at a disabled level.
+func disabledScenarios() []scenario {
+	var disabled []scenario
+	for _, s := range scenarios {
+		if strings.HasPrefix(s.name, "Disabled") {
+			disabled = append(disabled, s)
+		}
+	}
+
+	return disabled
+}
+
+// TestDisabledNoWrites checks that no library writes anything when logging
+// at a disabled level, which would point at a logger built with the wrong
+// level.
+func TestDisabledNoWrites(t *testing.T) {
+	for _, s := range disabledScenarios() {
+		t.Run(s.name, func(t *testing.T) {
+			for _, v := range loggers {
+				t.Run(v.name(), func(t *testing.T) {
+					out := &blackhole{}
+					l := s.new(v, out)
+
+					for i := 0; i < 10; i++ {
+						s.log(l)
+					}
+
+					if out.WriteCount() != 0 {
+						t.Errorf("Disabled event was written %d times", out.WriteCount())
+					}
+				})
+			}
+		})
+	}
+}
+
+// TestDisabledNoAllocs guards the libraries listed in allocFreeDisabled
+// against regressions that make the disabled path allocate.
+func TestDisabledNoAllocs(t *testing.T) {
+	if raceEnabled {
+		t.Skip("the race detector allocates")
+	}
+
+	for _, s := range disabledScenarios() {
+		t.Run(s.name, func(t *testing.T) {
+			for _, v := range loggers {
+				if !slices.Contains(allocFreeDisabled[s.name], v.name()) {
+					continue
+				}
+
+				t.Run(v.name(), func(t *testing.T) {
+					l := s.new(v, &blackhole{})
+
+					if n := testing.AllocsPerRun(100, func() { s.log(l) }); n != 0 {
+						t.Errorf("Expected no allocations, got %v per event", n)
+					}
+				})
+			}
+		})
+	}
+}
@@ -1,62 +1,56 @@
 import ApexCharts from 'apexcharts';
 import chart from './chart.js';
-import data from '../bench.json';
-
-let categories = [];
-let libraries = [];
+import data from './data.json';
+
+const run = data.Runs[0];
+
+// Charts compare top-level scenarios only, at the highest GOMAXPROCS value in
+// the run. Modes such as GCImpact/Event measure different things.
+const scenarios = data.Scenarios.filter((s) => s.Name === s.Benchmark);
+const procs = Math.max(...run.Results.map((r) => r.Procs));
+const results = run.Results.filter((r) => r.Procs === procs);
+
+const enabledCategories = scenarios
+  .filter((s) => !s.Disabled)
+  .map((s) => s.Name);
+const disabledCategories = scenarios
+  .filter((s) => s.Disabled)
+  .map((s) => s.Name);
+
+function metricSeries(categories, metric) {
+  return data.Libraries.map((lib) => ({
+    name: lib.Name,
+    data: categories.map((scenario) => {
+      const r = results.find(
+        (r) => r.Scenario === scenario && r.Library === lib.Name
+      );
+      return r ? r.Metrics[metric] : null;
+    }),
+  }));
+}
 
 const series = {
-  executionTime: [],
-  executionTimeDisabled: [],
-  memoryUsage: [],
-  memoryUsageDisabled: [],
-  totalRuns: [],
-  totalRunsDisabled: [],
-  allocations: [],
-  allocationsDisabled: [],
+  executionTime: metricSeries(enabledCategories, 'ns/op'),
+  executionTimeDisabled: metricSeries(disabledCategories, 'ns/op'),
+  memoryUsage: metricSeries(enabledCategories, 'B/op'),
+  memoryUsageDisabled: metricSeries(disabledCategories, 'B/op'),
+  totalRuns: metricSeries(enabledCategories, 'runs'),
+  totalRunsDisabled: metricSeries(disabledCategories, 'runs'),
+  allocations: metricSeries(enabledCategories, 'allocs/op'),
+  allocationsDisabled: metricSeries(disabledCategories, 'allocs/op'),
 };
 
-function pushToCharts(prop, lib, value) {
-  const i = series[prop].findIndex((obj) => obj.name === lib);
-  if (i === -1) {
-    series[prop].push({
-      name: lib,
-      data: [value],
-    });
-  } else {
-    series[prop][i].data.push(value);
-  }
-}
-
-const benchmarks = data[0].Suites[0].Benchmarks;
-
-benchmarks.forEach((item) => {
-  const benchName = item.Name.split('/')[0].split('Benchmark')[1];
-  const library = item.Name.split('/')[1].split('-')[0];
-  categories.push(benchName);
-  libraries.push(library);
+const env = run.Env;
+if (env) {
+  const versions = Object.entries(env.Libraries)
+    .map(([lib, version]) => `${lib} ${version}`)
+    .join(', ');
 
-  if (!benchName.includes('Disabled')) {
-    pushToCharts('executionTime', library, item.NsPerOp);
-    pushToCharts('memoryUsage', library, item.Mem.BytesPerOp);
-    pushToCharts('totalRuns', library, item.Runs);
-    pushToCharts('allocations', library, item.Mem.AllocsPerOp);
-  } else {
-    pushToCharts('executionTimeDisabled', library, item.NsPerOp);
-    pushToCharts('memoryUsageDisabled', library, item.Mem.BytesPerOp);
-    pushToCharts('totalRunsDisabled', library, item.Runs);
-    pushToCharts('allocationsDisabled', library, item.Mem.AllocsPerOp);
-  }
-});
-
-const uniqueCategories = Array.from(new Set(categories));
-
-const enabledCategories = uniqueCategories.filter(
-  (e) => !e.includes('Disabled')
-);
-const disabledCategories = uniqueCategories.filter((e) =>
-  e.includes('Disabled')
-);
+  document.querySelector('#js-env').textContent =
+    `Measured with ${env.GoVersion} on ${env.CPU} (${env.NumCPU} cores, ` +
+    `GOMAXPROCS=${env.GOMAXPROCS}, ${env.Goos}/${env.Goarch}, kernel ` +
+    `${env.Kernel}) using ${versions}.`;
+}
 
 const executionTimeChart = new ApexCharts(
   document.querySelector('#js-nano-chart'),
@@ -0,0 +1,132 @@
+package bench
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"os"
+	"os/exec"
+	"runtime"
+	"runtime/debug"
+	"strings"
+)
+
+var envFile = flag.String(
+	"env-file",
+	"",
+	"write a JSON description of the machine, Go toolchain and library versions to this file",
+)
+
+// libraryModules maps the name of each benchmarked library to the module that
+// provides it. Slog is part of the standard library and is versioned with Go.
+var libraryModules = map[string]string{
+	"Zerolog":  "github.com/rs/zerolog",
+	"Phuslog":  "github.com/phuslu/log",
+	"Zap":      "go.uber.org/zap",
+	"ZapSugar": "go.uber.org/zap",
+	"SlogZap":  "go.uber.org/zap/exp",
+	"Apex":     "github.com/apex/log",
+	"Logrus":   "github.com/sirupsen/logrus",
+	"Log15":    "github.com/inconshreveable/log15",
+	"Logf":     "github.com/zerodha/logf",
+}
+
+// env fingerprints the environment that produced a set of results, so that
+// runs can be compared and the dashboard can show what was tested.
+type env struct {
+	GoVersion  string
+	Goos       string
+	Goarch     string
+	CPU        string
+	NumCPU     int
+	GOMAXPROCS int
+	Kernel     string
+	// Libraries maps each library name to the version that was tested.
+	Libraries map[string]string
+	// Modules maps every module dependency to its version.
+	Modules map[string]string
+}
+
+func collectEnv() env {
+	e := env{
+		GoVersion:  runtime.Version(),
+		Goos:       runtime.GOOS,
+		Goarch:     runtime.GOARCH,
+		CPU:        cpuModel(),
+		NumCPU:     runtime.NumCPU(),
+		GOMAXPROCS: runtime.GOMAXPROCS(0),
+		Kernel:     kernelVersion(),
+		Libraries:  make(map[string]string),
+		Modules:    make(map[string]string),
+	}
+
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			version := dep.Version
+			if dep.Replace != nil {
+				version = dep.Replace.Version
+			}
+
+			e.Modules[dep.Path] = version
+		}
+	}
+
+	for _, v := range loggers {
+		if module, ok := libraryModules[v.name()]; ok {
+			e.Libraries[v.name()] = e.Modules[module]
+		} else {
+			e.Libraries[v.name()] = e.GoVersion
+		}
+	}
+
+	return e
+}
+
+func writeEnv(path string) error {
+	b, err := json.MarshalIndent(collectEnv(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(b, '\n'), 0o644)
+}
+
+// cpuModel returns the CPU model name, or "unknown" if it cannot be found.
+func cpuModel() string {
+	switch runtime.GOOS {
+	case "linux":
+		f, err := os.Open("/proc/cpuinfo")
+		if err != nil {
+			break
+		}
+		defer f.Close()
+
+		sc := bufio.NewScanner(f)
+		for sc.Scan() {
+			key, value, ok := strings.Cut(sc.Text(), ":")
+			if ok && strings.TrimSpace(key) == "model name" {
+				return strings.TrimSpace(value)
+			}
+		}
+	case "darwin":
+		if out, err := exec.Command("sysctl", "-n", "machdep.cpu.brand_string").Output(); err == nil {
+			return strings.TrimSpace(string(out))
+		}
+	}
+
+	return "unknown"
+}
+
+// kernelVersion returns the release of the running kernel, or "unknown" if
+// it cannot be found.
+func kernelVersion() string {
+	if b, err := os.ReadFile("/proc/sys/kernel/osrelease"); err == nil {
+		return strings.TrimSpace(string(b))
+	}
+
+	if out, err := exec.Command("uname", "-r").Output(); err == nil {
+		return strings.TrimSpace(string(out))
+	}
+
+	return "unknown"
+}
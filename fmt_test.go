@@ -0,0 +1,33 @@
+package bench
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestFmtFixtures rejects fixtures whose verbs and arguments disagree, which
+// would make the Fmt scenarios measure the error path of fmt.
+func TestFmtFixtures(t *testing.T) {
+	for _, f := range fmtFixtures {
+		if err := checkFmtFixture(f.format, f.args); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+// TestEventFmtOutput checks that every library logs the message that fmt
+// renders for each fixture.
+func TestEventFmtOutput(t *testing.T) {
+	for _, v := range loggers {
+		t.Run(v.name(), func(t *testing.T) {
+			for _, f := range fmtFixtures {
+				var buf bytes.Buffer
+				v.new(&buf).logEventFmt(f.format, f.args...)
+
+				for _, p := range checkFmtOutput(buf.Bytes(), f.format, f.args) {
+					t.Errorf("%q: %s", f.format, p)
+				}
+			}
+		})
+	}
+}
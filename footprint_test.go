@@ -0,0 +1,78 @@
+package bench
+
+import (
+	"io"
+	"runtime"
+	"testing"
+)
+
+// retainedLoggers is the number of loggers kept alive to measure the heap
+// retained by each one.
+const retainedLoggers = 1000
+
+// BenchmarkNewLogger tests the cost of creating a logger without context.
+// The retained-bytes/logger metric reports the heap it keeps alive.
+func BenchmarkNewLogger(b *testing.B) {
+	b.Logf("Create a logger without any accumulated context")
+
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			benchmarkNewLogger(b, func() logBenchmark { return v.new(io.Discard) })
+		})
+	}
+}
+
+// BenchmarkNewLoggerWithCtx tests the cost of creating a logger that holds
+// the nine contextual fields of the AccumulatedCtx scenarios. Libraries that
+// encode the context up front retain very different amounts of heap from
+// those that keep it in a map, which the retained-bytes/logger metric shows.
+// The Logrus adapter drops the fields in newWithCtx, so its footprint is that
+// of a logger without context.
+func BenchmarkNewLoggerWithCtx(b *testing.B) {
+	b.Logf("Create a logger with some accumulated context")
+
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			benchmarkNewLogger(b, func() logBenchmark { return v.newWithCtx(io.Discard) })
+		})
+	}
+}
+
+func benchmarkNewLogger(b *testing.B, newLogger func() logBenchmark) {
+	retained := retainedBytes(newLogger)
+
+	b.ResetTimer()
+
+	runParallel(b, func(pb *testing.PB) {
+		for pb.Next() {
+			newLogger()
+		}
+	})
+
+	b.ReportMetric(retained, "retained-bytes/logger")
+}
+
+// retainedBytes returns the heap kept alive by each logger returned by
+// newLogger, averaged over retainedLoggers of them.
+func retainedBytes(newLogger func() logBenchmark) float64 {
+	kept := make([]logBenchmark, retainedLoggers)
+
+	var before, after runtime.MemStats
+
+	// Two collections empty the sync.Pools, so that buffers the libraries
+	// take from them are counted and buffers they return are not.
+	runtime.GC()
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+
+	for i := range kept {
+		kept[i] = newLogger()
+	}
+
+	runtime.GC()
+	runtime.GC()
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(kept)
+
+	return max(0, float64(int64(after.HeapAlloc)-int64(before.HeapAlloc))) / retainedLoggers
+}
@@ -0,0 +1,193 @@
+package bench
+
+import (
+	"flag"
+	"runtime"
+	"runtime/metrics"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var gcImpact = flag.Duration(
+	"gc-impact",
+	0,
+	"run each scenario for this long next to an allocating workload in BenchmarkGCImpact",
+)
+
+// BenchmarkGCImpact measures the effect of each library on the garbage
+// collector and on the rest of the application. Every scenario is run for the
+// duration given by -gc-impact while a simulated workload allocates in the
+// background, and the following metrics are reported:
+//
+//   - gc-cycles: garbage collections during the run
+//   - gc-pause-ns: total stop-the-world pause time
+//   - heap-peak-bytes: highest heap in use observed
+//   - workload-slowdown-%: drop in workload throughput compared to running
+//     the workload alone
+//
+// The events are logged by GOMAXPROCS-1 goroutines, leaving a CPU to the
+// workload, or by a single one sharing the CPU with it when GOMAXPROCS is 1.
+// The workload slowdown includes CPU contention with the logging goroutines,
+// so it is only meaningful when comparing libraries with each other. Each run
+// ignores b.N, so use -benchtime=1x to avoid repeating it.
+func BenchmarkGCImpact(b *testing.B) {
+	if *gcImpact <= 0 {
+		b.Skip("set -gc-impact to a duration to measure the impact on the GC")
+	}
+
+	b.Logf("Log events next to an allocating workload and measure the GC impact")
+
+	baseline := baselineWorkloadOps(*gcImpact)
+
+	for _, s := range scenarios {
+		b.Run(s.name, func(b *testing.B) {
+			for _, v := range loggers {
+				b.Run(v.name(), func(b *testing.B) {
+					l := s.new(v, &blackhole{})
+					r := measureGCImpact(l, s, *gcImpact)
+
+					if r.events == 0 {
+						b.Fatalf("No event was logged in %s; increase -gc-impact", *gcImpact)
+					}
+
+					b.ReportMetric(float64(r.elapsed.Nanoseconds())/float64(r.events), "ns/op")
+					b.ReportMetric(float64(r.gcCycles), "gc-cycles")
+					b.ReportMetric(float64(r.pauseNs), "gc-pause-ns")
+					b.ReportMetric(float64(r.heapPeak), "heap-peak-bytes")
+					b.ReportMetric(
+						100*(1-float64(r.workloadOps)/float64(baseline)),
+						"workload-slowdown-%",
+					)
+				})
+			}
+		})
+	}
+}
+
+type gcImpactResult struct {
+	elapsed     time.Duration
+	events      uint64
+	workloadOps uint64
+	gcCycles    uint32
+	pauseNs     uint64
+	heapPeak    uint64
+}
+
+// workloadSink keeps the allocations of the workload reachable so that they
+// are not optimized away and form a steady live heap.
+var workloadSink [4096][]byte
+
+// allocWorkload simulates an application that allocates short-lived buffers
+// while keeping a window of recent ones alive. It returns the number of
+// operations completed before stop was set.
+func allocWorkload(stop *atomic.Bool) uint64 {
+	var ops uint64
+
+	for !stop.Load() {
+		buf := make([]byte, 256+ops%768)
+		buf[0] = byte(ops)
+		workloadSink[ops%uint64(len(workloadSink))] = buf
+		ops++
+	}
+
+	return ops
+}
+
+var (
+	baselineOnce sync.Once
+	baselineOps  uint64
+)
+
+// baselineWorkloadOps returns the throughput of the workload running alone
+// for d, measured once per process.
+func baselineWorkloadOps(d time.Duration) uint64 {
+	baselineOnce.Do(func() {
+		runtime.GC()
+
+		var stop atomic.Bool
+		time.AfterFunc(d, func() { stop.Store(true) })
+		baselineOps = allocWorkload(&stop)
+	})
+
+	return baselineOps
+}
+
+// measureGCImpact runs the workload and logs with s on gcImpactWorkers
+// workers for d, collecting GC statistics and sampling the heap along the
+// way.
+func measureGCImpact(l logBenchmark, s scenario, d time.Duration) gcImpactResult {
+	var r gcImpactResult
+
+	runtime.GC()
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+
+	var stop atomic.Bool
+	var wg sync.WaitGroup
+
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		r.workloadOps = allocWorkload(&stop)
+	}()
+
+	go func() {
+		defer wg.Done()
+		r.heapPeak = sampleHeapPeak(&stop)
+	}()
+
+	start := time.Now()
+	time.AfterFunc(d, func() { stop.Store(true) })
+	r.events = logUntil(l, s, gcImpactWorkers(), 0, &stop)
+	r.elapsed = time.Since(start)
+
+	wg.Wait()
+	runtime.ReadMemStats(&after)
+
+	r.gcCycles = after.NumGC - before.NumGC
+	r.pauseNs = after.PauseTotalNs - before.PauseTotalNs
+
+	return r
+}
+
+// gcImpactWorkers returns the number of goroutines logging next to the
+// workload: one per CPU except the one left to the workload, and at least
+// one.
+func gcImpactWorkers() int {
+	return max(1, runtime.GOMAXPROCS(0)-1)
+}
+
+var heapInUseMetrics = []metrics.Sample{
+	{Name: "/memory/classes/heap/objects:bytes"},
+	{Name: "/memory/classes/heap/unused:bytes"},
+}
+
+// sampleHeapPeak samples the heap in use every millisecond until stop is set
+// and returns the highest value seen.
+func sampleHeapPeak(stop *atomic.Bool) uint64 {
+	samples := make([]metrics.Sample, len(heapInUseMetrics))
+	copy(samples, heapInUseMetrics)
+
+	var peak uint64
+
+	for !stop.Load() {
+		metrics.Read(samples)
+
+		var inUse uint64
+		for _, s := range samples {
+			inUse += s.Value.Uint64()
+		}
+
+		if inUse > peak {
+			peak = inUse
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	return peak
+}
@@ -0,0 +1,29 @@
+package bench
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestGroupsOutput checks that every library nests the fields of the Groups
+// scenarios the same way, whether they are logged with the event or
+// accumulated by the logger.
+func TestGroupsOutput(t *testing.T) {
+	for _, v := range loggers {
+		t.Run(v.name(), func(t *testing.T) {
+			var buf bytes.Buffer
+			v.new(&buf).logEventGroups(logMsg)
+
+			for _, p := range checkGroupsOutput(buf.Bytes(), logMsg) {
+				t.Errorf("EventGroups: %s", p)
+			}
+
+			buf.Reset()
+			v.newWithGroups(&buf).logEvent(logMsg)
+
+			for _, p := range checkGroupsOutput(buf.Bytes(), logMsg) {
+				t.Errorf("EventAccumulatedGroups: %s", p)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package bench
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// serializesWrites lists the libraries that hold a lock while writing to the
+// underlying io.Writer, so a writer that is not safe for concurrent use can be
+// shared by goroutines logging at the same time:
+//
+//   - Slog: the built-in handlers lock around each write
+//   - Apex: the JSON handler holds a mutex
+//   - Logrus: the logger holds a mutex unless SetNoLock is called
+//   - Log15: StreamHandler is wrapped in a SyncHandler
+//   - Logf: the writer is wrapped in a synchronized writer
+//
+// The others write concurrently and need a synchronized writer: wrap it with
+// zapcore.Lock for Zap, ZapSugar and SlogZap, with zerolog.SyncWriter for
+// Zerolog, and with a mutex for Phuslog, whose IOWriter does not lock.
+// TestConcurrentOutputUnsynchronized checks this list against the behavior of
+// every library.
+var serializesWrites = map[string]bool{
+	"Slog":   true,
+	"Apex":   true,
+	"Logrus": true,
+	"Log15":  true,
+	"Logf":   true,
+}
+
+const (
+	integrityGoroutines = 8
+	integrityEvents     = 100
+)
+
+// lockedBuffer is a bytes.Buffer that is safe for concurrent use.
+type lockedBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *lockedBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+// unsyncBuffer stands in for a writer that is not safe for concurrent use. It
+// writes every p in two halves and yields in between, so concurrent writes
+// tear each other's lines as they would on such a writer, and it counts the
+// writes that started while another one was in progress. Its own state is
+// locked, so that overlapping writes corrupt the output without crashing the
+// test or tripping the race detector.
+type unsyncBuffer struct {
+	lockedBuffer
+	active   atomic.Int32
+	overlaps atomic.Int64
+}
+
+func (b *unsyncBuffer) Write(p []byte) (int, error) {
+	if b.active.Add(1) > 1 {
+		b.overlaps.Add(1)
+	}
+	defer b.active.Add(-1)
+
+	half := len(p) / 2
+
+	b.lockedBuffer.Write(p[:half])
+	runtime.Gosched()
+	b.lockedBuffer.Write(p[half:])
+
+	return len(p), nil
+}
+
+// TestConcurrentOutputSynchronized logs from many goroutines sharing one
+// logger and one synchronized writer, and fails if any line is corrupted.
+func TestConcurrentOutputSynchronized(t *testing.T) {
+	for _, v := range loggers {
+		for _, s := range integrityScenarios() {
+			t.Run(v.name()+"/"+s.name, func(t *testing.T) {
+				out := &lockedBuffer{}
+				logConcurrently(s.new(v, out), s)
+				checkLines(t, v, s, out.buf.Bytes())
+			})
+		}
+	}
+}
+
+// TestConcurrentOutputUnsynchronized logs from many goroutines sharing one
+// logger and a writer that is not safe for concurrent use. The output of the
+// libraries in serializesWrites must be intact, while the others are expected
+// to write concurrently and corrupt it. Run it with -race to also catch
+// unsynchronized access inside the libraries.
+func TestConcurrentOutputUnsynchronized(t *testing.T) {
+	for _, v := range loggers {
+		for _, s := range integrityScenarios() {
+			t.Run(v.name()+"/"+s.name, func(t *testing.T) {
+				out := &unsyncBuffer{}
+				logConcurrently(s.new(v, out), s)
+
+				overlaps := out.overlaps.Load()
+
+				if serializesWrites[v.name()] {
+					if overlaps > 0 {
+						t.Fatalf("%s is in serializesWrites, but %d writes overlapped", v.name(), overlaps)
+					}
+
+					checkLines(t, v, s, out.buf.Bytes())
+
+					return
+				}
+
+				if overlaps == 0 {
+					t.Fatalf(
+						"%s wrote without overlapping writes; if it serializes them, add it to serializesWrites",
+						v.name(),
+					)
+				}
+
+				_, corrupted := scanLines(out.buf.Bytes())
+				t.Logf("%d writes overlapped and corrupted %d lines", overlaps, len(corrupted))
+			})
+		}
+	}
+}
+
+// integrityScenarios returns the scenarios that log logMsg at an enabled
+// level.
+func integrityScenarios() []scenario {
+	var enabled []scenario
+
+	for _, s := range scenarios {
+		if !strings.HasPrefix(s.name, "Disabled") && s.name != "EventFmt" {
+			enabled = append(enabled, s)
+		}
+	}
+
+	return enabled
+}
+
+func logConcurrently(l logBenchmark, s scenario) {
+	var wg sync.WaitGroup
+
+	for g := 0; g < integrityGoroutines; g++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := 0; i < integrityEvents; i++ {
+				s.log(l)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+// checkLines parses every line of out and fails the test if any of them is
+// not a complete event with the expected message, or if events are missing.
+// Corrupted lines are only logged if the problem is in knownOutputProblems,
+// and a known problem that no longer occurs fails the test.
+func checkLines(t *testing.T, v logBenchmark, s scenario, out []byte) {
+	t.Helper()
+
+	lines, corrupted := scanLines(out)
+
+	if known, ok := knownOutputProblems[s.name+"/"+v.name()]; ok {
+		if len(corrupted) == 0 {
+			t.Errorf("Known problem no longer occurs: %s", known)
+		} else {
+			t.Logf("Known problem in %d lines: %s", len(corrupted), known)
+		}
+	} else if len(corrupted) > 0 {
+		t.Fatal(corrupted[0])
+	}
+
+	if expected := integrityGoroutines * integrityEvents; lines != expected {
+		t.Fatalf("Mismatch in logged line count. Expected: %d, Actual: %d", expected, lines)
+	}
+}
+
+// scanLines returns the number of lines in out and a description of each
+// line that is not a complete event with the expected message.
+func scanLines(out []byte) (int, []string) {
+	var (
+		lines     int
+		corrupted []string
+	)
+
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	sc.Buffer(nil, 1<<20)
+
+	for sc.Scan() {
+		lines++
+
+		e, err := parseEvent(sc.Bytes())
+		if err != nil {
+			corrupted = append(corrupted, fmt.Sprintf("line %d is corrupted: %v\n%s", lines, err, sc.Bytes()))
+		} else if p := e.check("msg", logMsg); p != "" {
+			corrupted = append(corrupted, fmt.Sprintf("line %d is corrupted: %s\n%s", lines, p, sc.Bytes()))
+		}
+	}
+
+	if err := sc.Err(); err != nil {
+		corrupted = append(corrupted, err.Error())
+	}
+
+	return lines, corrupted
+}
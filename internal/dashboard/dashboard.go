@@ -0,0 +1,346 @@
+// Package dashboard converts the results written by gobenchdata into a stable
+// schema that describes the scenarios, the libraries and the metrics of
+// every run, without requiring readers to parse benchmark names.
+package dashboard
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// rawRun is a single run in the file written by gobenchdata.
+type rawRun struct {
+	Version string
+	Date    int64
+	Tags    []string
+	Suites  []struct {
+		Goos       string
+		Goarch     string
+		Pkg        string
+		Benchmarks []struct {
+			Name    string
+			Runs    int64
+			NsPerOp float64
+			Mem     struct {
+				BytesPerOp  float64
+				AllocsPerOp float64
+				MBPerSec    float64
+			}
+			Custom map[string]float64
+		}
+	}
+	// Env is recorded by the -env-file flag of the benchmarks.
+	Env json.RawMessage `json:",omitempty"`
+}
+
+// Dashboard is the document read by the front end.
+type Dashboard struct {
+	Scenarios []Scenario
+	Libraries []Library
+	// Runs are sorted from newest to oldest.
+	Runs []Run
+}
+
+// Scenario is a benchmarked scenario such as "EventCtx" or "GCImpact/Event".
+type Scenario struct {
+	Name string
+	// Benchmark is the Benchmark function the scenario belongs to, without
+	// its "Benchmark" prefix.
+	Benchmark   string
+	Description string
+	// Disabled is set for scenarios that log below the enabled level.
+	Disabled bool
+}
+
+// Library is a benchmarked logging library.
+type Library struct {
+	Name    string
+	Version string `json:",omitempty"`
+	// Capabilities reports for every scenario whether the library has
+	// results for it. Libraries skip scenarios they cannot support, such as
+	// LevelSwitch without a way to change the level at runtime.
+	Capabilities map[string]bool
+}
+
+// Run holds the results of a single invocation of the benchmarks.
+type Run struct {
+	Version string          `json:",omitempty"`
+	Date    int64           `json:",omitempty"`
+	Tags    []string        `json:",omitempty"`
+	Env     json.RawMessage `json:",omitempty"`
+	Results []Result
+}
+
+// Env describes the machine, Go toolchain and library versions of a run, as
+// recorded by the -env-file flag of the benchmarks.
+type Env struct {
+	GoVersion  string
+	Goos       string
+	Goarch     string
+	CPU        string
+	NumCPU     int
+	GOMAXPROCS int
+	Kernel     string
+	Libraries  map[string]string
+	Modules    map[string]string
+}
+
+// Result holds the metrics of a single sub-benchmark.
+type Result struct {
+	Scenario string
+	Library  string
+	// Procs is the GOMAXPROCS value the benchmark ran with, taken from the
+	// suffix of its name.
+	Procs int
+	// Metrics are keyed by unit, such as "ns/op" or "allocs/op".
+	Metrics map[string]float64
+}
+
+// Load reads the results written by gobenchdata to path and builds a
+// Dashboard from them, taking the scenario descriptions from the benchmark
+// sources in src.
+func Load(path, src string) (Dashboard, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return Dashboard{}, err
+	}
+
+	var runs []rawRun
+	if err := json.Unmarshal(b, &runs); err != nil {
+		return Dashboard{}, fmt.Errorf("decoding %s: %w", path, err)
+	}
+
+	descriptions, err := benchmarkDescriptions(src)
+	if err != nil {
+		return Dashboard{}, err
+	}
+
+	return build(runs, descriptions)
+}
+
+func build(runs []rawRun, descriptions map[string]string) (Dashboard, error) {
+	var d Dashboard
+
+	sort.SliceStable(runs, func(i, j int) bool { return runs[i].Date > runs[j].Date })
+
+	scenarios := make(map[string]bool)
+	libraries := make(map[string]bool)
+	supported := make(map[[2]string]bool)
+
+	for _, raw := range runs {
+		r := Run{Version: raw.Version, Date: raw.Date, Tags: raw.Tags, Env: raw.Env}
+
+		for _, suite := range raw.Suites {
+			for _, bench := range suite.Benchmarks {
+				benchmark, scenario, library, procs, err := parseName(bench.Name)
+				if err != nil {
+					return Dashboard{}, err
+				}
+
+				metrics := map[string]float64{
+					"runs":      float64(bench.Runs),
+					"ns/op":     bench.NsPerOp,
+					"B/op":      bench.Mem.BytesPerOp,
+					"allocs/op": bench.Mem.AllocsPerOp,
+				}
+				if bench.Mem.MBPerSec != 0 {
+					metrics["MB/s"] = bench.Mem.MBPerSec
+				}
+				for unit, v := range bench.Custom {
+					metrics[unit] = v
+				}
+
+				r.Results = append(r.Results, Result{
+					Scenario: scenario,
+					Library:  library,
+					Procs:    procs,
+					Metrics:  metrics,
+				})
+
+				if !scenarios[scenario] {
+					scenarios[scenario] = true
+					d.Scenarios = append(d.Scenarios, Scenario{
+						Name:        scenario,
+						Benchmark:   benchmark,
+						Description: descriptions[benchmark],
+						Disabled:    strings.Contains(scenario, "Disabled"),
+					})
+				}
+
+				if !libraries[library] {
+					libraries[library] = true
+					d.Libraries = append(d.Libraries, Library{Name: library})
+				}
+
+				supported[[2]string{library, scenario}] = true
+			}
+		}
+
+		d.Runs = append(d.Runs, r)
+	}
+
+	var versions map[string]string
+	if len(d.Runs) > 0 && len(d.Runs[0].Env) > 0 {
+		env, err := d.Runs[0].Environment()
+		if err != nil {
+			return Dashboard{}, err
+		}
+
+		versions = env.Libraries
+	}
+
+	for i := range d.Libraries {
+		l := &d.Libraries[i]
+		l.Version = versions[l.Name]
+		l.Capabilities = make(map[string]bool, len(d.Scenarios))
+
+		for _, s := range d.Scenarios {
+			l.Capabilities[s.Name] = supported[[2]string{l.Name, s.Name}]
+		}
+	}
+
+	return d, nil
+}
+
+// parseName splits a benchmark name such as "BenchmarkGCImpact/Event/Zap-4"
+// into the Benchmark function ("GCImpact"), the scenario ("GCImpact/Event"),
+// the library ("Zap") and the GOMAXPROCS suffix (4). The suffix is omitted
+// by go test when GOMAXPROCS is 1.
+func parseName(name string) (benchmark, scenario, library string, procs int, err error) {
+	rest, ok := strings.CutPrefix(name, "Benchmark")
+	if !ok {
+		return "", "", "", 0, fmt.Errorf("%q is not a benchmark name", name)
+	}
+
+	procs = 1
+	if i := strings.LastIndexByte(rest, '-'); i != -1 {
+		if n, err := strconv.Atoi(rest[i+1:]); err == nil {
+			rest, procs = rest[:i], n
+		}
+	}
+
+	i := strings.LastIndexByte(rest, '/')
+	if i == -1 {
+		return "", "", "", 0, fmt.Errorf("%q has no library sub-benchmark", name)
+	}
+
+	scenario, library = rest[:i], rest[i+1:]
+	benchmark, _, _ = strings.Cut(scenario, "/")
+
+	return benchmark, scenario, library, procs, nil
+}
+
+// benchmarkDescriptions returns the text of the first b.Logf call of every
+// Benchmark function in the test files of dir, keyed by the function name
+// without its "Benchmark" prefix.
+func benchmarkDescriptions(dir string) (map[string]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*_test.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	descriptions := make(map[string]string)
+	fset := token.NewFileSet()
+
+	for _, path := range files {
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || fn.Body == nil {
+				continue
+			}
+
+			name, ok := strings.CutPrefix(fn.Name.Name, "Benchmark")
+			if !ok || name == "" {
+				continue
+			}
+
+			if desc, ok := firstLogf(fn.Body); ok {
+				descriptions[name] = desc
+			}
+		}
+	}
+
+	return descriptions, nil
+}
+
+// firstLogf returns the string literal passed to the first b.Logf call in
+// body.
+func firstLogf(body *ast.BlockStmt) (string, bool) {
+	var desc string
+	var found bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Logf" {
+			return true
+		}
+
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+
+		if s, err := strconv.Unquote(lit.Value); err == nil {
+			desc, found = s, true
+		}
+
+		return !found
+	})
+
+	return desc, found
+}
+
+// MaxProcs returns the highest GOMAXPROCS value that r has results for.
+func (r Run) MaxProcs() int {
+	procs := 0
+	for _, res := range r.Results {
+		procs = max(procs, res.Procs)
+	}
+
+	return procs
+}
+
+// ScenarioResults returns the results of r for a scenario at the given GOMAXPROCS
+// value, in the order the libraries were benchmarked.
+func (r Run) ScenarioResults(scenario string, procs int) []Result {
+	var results []Result
+	for _, res := range r.Results {
+		if res.Scenario == scenario && res.Procs == procs {
+			results = append(results, res)
+		}
+	}
+
+	return results
+}
+
+// Environment decodes the environment recorded with r.
+func (r Run) Environment() (Env, error) {
+	var env Env
+	if err := json.Unmarshal(r.Env, &env); err != nil {
+		return Env{}, fmt.Errorf("decoding environment: %w", err)
+	}
+
+	return env, nil
+}
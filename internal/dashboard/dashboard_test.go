@@ -0,0 +1,41 @@
+package dashboard
+
+import "testing"
+
+func TestParseName(t *testing.T) {
+	tests := []struct {
+		name      string
+		benchmark string
+		scenario  string
+		library   string
+		procs     int
+	}{
+		{"BenchmarkEvent/Zap-4", "Event", "Event", "Zap", 4},
+		{"BenchmarkEvent/Zap", "Event", "Event", "Zap", 1},
+		{"BenchmarkGCImpact/EventCtx/Log15-8", "GCImpact", "GCImpact/EventCtx", "Log15", 8},
+		{"BenchmarkLoad/Disabled/SlogZap", "Load", "Load/Disabled", "SlogZap", 1},
+	}
+
+	for _, tt := range tests {
+		benchmark, scenario, library, procs, err := parseName(tt.name)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+
+		if benchmark != tt.benchmark || scenario != tt.scenario ||
+			library != tt.library || procs != tt.procs {
+			t.Errorf(
+				"%s: got (%q, %q, %q, %d), want (%q, %q, %q, %d)",
+				tt.name, benchmark, scenario, library, procs,
+				tt.benchmark, tt.scenario, tt.library, tt.procs,
+			)
+		}
+	}
+
+	for _, name := range []string{"TestEvent/Zap", "BenchmarkEvent-4"} {
+		if _, _, _, _, err := parseName(name); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package bench
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestLazyEvaluation checks that every library computes a lazy field exactly
+// once when the event is enabled, logs its value, and never computes it when
+// the event is disabled.
+func TestLazyEvaluation(t *testing.T) {
+	digest := expensiveDigest()
+
+	for _, v := range loggers {
+		t.Run(v.name(), func(t *testing.T) {
+			var buf bytes.Buffer
+			l := v.new(&buf)
+
+			expensiveCalls.Store(0)
+			l.logEventLazy(logMsg)
+
+			if n := expensiveCalls.Load(); n != 1 {
+				t.Errorf("Expected the enabled event to compute the field once, got %d", n)
+			}
+
+			for _, p := range checkOutput(buf.Bytes(), logMsg, []any{"digest", digest}) {
+				t.Error(p)
+			}
+
+			buf.Reset()
+			expensiveCalls.Store(0)
+			l.logDisabledLazy(logMsg)
+
+			if n := expensiveCalls.Load(); n != 0 {
+				t.Errorf("Expected the disabled event not to compute the field, got %d calls", n)
+			}
+
+			if buf.Len() != 0 {
+				t.Errorf("Disabled event was written: %q", buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package bench
+
+import (
+	"flag"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var (
+	loadDuration = flag.Duration(
+		"load",
+		0,
+		"run each scenario for this long in BenchmarkLoad and report its throughput",
+	)
+	loadWorkers = flag.Int(
+		"load-workers",
+		runtime.GOMAXPROCS(0),
+		"number of goroutines logging in BenchmarkLoad",
+	)
+	loadRate = flag.Float64(
+		"load-rate",
+		0,
+		"target events per second across all workers in BenchmarkLoad, 0 for as fast as possible",
+	)
+)
+
+// BenchmarkLoad generates load for a fixed wall time instead of letting the
+// testing package pick b.N, so that the event counts measure throughput
+// rather than benchmark calibration. Each scenario is run with -load-workers
+// goroutines for the duration given by -load, optionally paced to
+// -load-rate, and the following metrics are reported:
+//
+//   - events/s: events logged per second
+//   - bytes/s: bytes written to the sink per second
+//   - rate-achieved-%: events/s as a percentage of -load-rate, if set
+//
+// Each run ignores b.N, so use -benchtime=1x to avoid repeating it.
+func BenchmarkLoad(b *testing.B) {
+	if *loadDuration <= 0 {
+		b.Skip("set -load to a duration to run the load generator")
+	}
+
+	b.Logf("Log events from a fixed number of workers for a fixed duration")
+
+	for _, s := range scenarios {
+		b.Run(s.name, func(b *testing.B) {
+			for _, v := range loggers {
+				b.Run(v.name(), func(b *testing.B) {
+					out := &blackhole{}
+					l := s.new(v, out)
+
+					var stop atomic.Bool
+
+					start := time.Now()
+					time.AfterFunc(*loadDuration, func() { stop.Store(true) })
+					events := logUntil(l, s, *loadWorkers, *loadRate, &stop)
+					elapsed := time.Since(start).Seconds()
+
+					if events == 0 {
+						b.Fatalf("No event was logged in %s; increase -load or -load-rate", *loadDuration)
+					}
+
+					eventsPerSec := float64(events) / elapsed
+
+					b.ReportMetric(elapsed*1e9/float64(events), "ns/op")
+					b.ReportMetric(eventsPerSec, "events/s")
+					b.ReportMetric(float64(out.ByteCount())/elapsed, "bytes/s")
+
+					if *loadRate > 0 {
+						b.ReportMetric(100*eventsPerSec / *loadRate, "rate-achieved-%")
+					}
+				})
+			}
+		})
+	}
+}
+
+// logUntil logs with s from the given number of goroutines, at least one,
+// until stop is set and returns the total number of events. If rate is
+// positive, the workers are paced to log that many events per second in
+// total.
+func logUntil(
+	l logBenchmark,
+	s scenario,
+	workers int,
+	rate float64,
+	stop *atomic.Bool,
+) uint64 {
+	if workers < 1 {
+		workers = 1
+	}
+
+	var interval time.Duration
+	if rate > 0 {
+		interval = time.Duration(float64(workers) / rate * float64(time.Second))
+	}
+
+	var events atomic.Uint64
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			start := time.Now()
+
+			var n uint64
+			for !stop.Load() {
+				if interval > 0 {
+					if wait := time.Until(start.Add(time.Duration(n) * interval)); wait > 0 {
+						time.Sleep(wait)
+					}
+				}
+
+				s.log(l)
+				n++
+			}
+
+			events.Add(n)
+		}()
+	}
+
+	wg.Wait()
+
+	return events.Load()
+}
@@ -1,6 +1,7 @@
 package bench
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -31,6 +32,29 @@ func (b *log15Bench) newWithCtx(w io.Writer) logBenchmark {
 	}
 }
 
+func (b *log15Bench) newWithGroups(w io.Writer) logBenchmark {
+	return &log15Bench{
+		l: newLog15(w).New(groupKeyValuePairs()...),
+	}
+}
+
+// newWithLevelSwitch swaps the level filter in front of the stream handler,
+// which log15 does atomically.
+func (b *log15Bench) newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool)) {
+	l := newLog15(w)
+	h := log15.StreamHandler(w, log15.JsonFormat())
+
+	return &log15Bench{
+		l: l,
+	}, func(debug bool) {
+		if debug {
+			l.SetHandler(log15.LvlFilterHandler(log15.LvlDebug, h))
+		} else {
+			l.SetHandler(log15.LvlFilterHandler(log15.LvlInfo, h))
+		}
+	}
+}
+
 func (b *log15Bench) name() string {
 	return "Log15"
 }
@@ -39,6 +63,14 @@ func (b *log15Bench) logEvent(msg string) {
 	b.l.Info(msg)
 }
 
+func (b *log15Bench) logEventWarn(msg string) {
+	b.l.Warn(msg)
+}
+
+func (b *log15Bench) logEventError(msg string) {
+	b.l.Error(msg)
+}
+
 func (b *log15Bench) logEventFmt(msg string, args ...any) {
 	b.l.Info(fmt.Sprintf(msg, args...))
 }
@@ -51,6 +83,59 @@ func (b *log15Bench) logEventCtxWeak(msg string) {
 	b.logEventCtx(msg)
 }
 
+func (b *log15Bench) logEventCtxWarn(msg string) {
+	b.l.Warn(msg, alternatingKeyValuePairs()...)
+}
+
+func (b *log15Bench) logEventCtxError(msg string) {
+	b.l.Error(msg, alternatingKeyValuePairs()...)
+}
+
+func (b *log15Bench) logEventErrorStack(msg string, err error) {
+	b.l.Error(msg, "error", err, "stack", stackTrace())
+}
+
+func (b *log15Bench) logEventKV(msg string, keysAndValues ...any) {
+	b.l.Info(msg, keysAndValues...)
+}
+
+func (b *log15Bench) logEventField(msg string, kind fieldKind) {
+	key, value := kind.keyValue()
+	b.l.Info(msg, key, value)
+}
+
+func (b *log15Bench) withTraceContext(ctx context.Context) context.Context {
+	return contextWithLogger(ctx, b.l.New(
+		"trace_id", ctxTraceID,
+		"span_id", ctxSpanID,
+	))
+}
+
+func (b *log15Bench) logEventFromContext(ctx context.Context, msg string) {
+	loggerFromContext[log15.Logger](ctx).Info(msg)
+}
+
+func (b *log15Bench) logEventLazy(msg string) {
+	b.l.Info(msg, "digest", log15.Lazy{Fn: expensiveDigest})
+}
+
+func (b *log15Bench) logRequest(msg string) {
+	l := b.l.New(
+		"request_id", reqID,
+		"method", reqMethod,
+		"path", reqPath,
+		"remote_addr", reqRemoteAddr,
+	)
+
+	for i := 0; i < requestEvents; i++ {
+		l.Info(msg)
+	}
+}
+
+func (b *log15Bench) logEventGroups(msg string) {
+	b.l.Info(msg, groupKeyValuePairs()...)
+}
+
 func (b *log15Bench) logDisabled(msg string) {
 	b.l.Debug(msg)
 }
@@ -63,6 +148,16 @@ func (b *log15Bench) logDisabledCtx(msg string) {
 	b.l.Debug(msg, alternatingKeyValuePairs()...)
 }
 
+// logDisabledCtxGuarded is not guarded as log15 filters levels in its
+// handlers and has no way to check whether a level is enabled.
+func (b *log15Bench) logDisabledCtxGuarded(msg string) {
+	b.logDisabledCtx(msg)
+}
+
 func (b *log15Bench) logDisabledCtxWeak(msg string) {
 	b.logDisabledCtx(msg)
 }
+
+func (b *log15Bench) logDisabledLazy(msg string) {
+	b.l.Debug(msg, "digest", log15.Lazy{Fn: expensiveDigest})
+}
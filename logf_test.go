@@ -1,6 +1,7 @@
 package bench
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"time"
@@ -8,6 +9,18 @@ import (
 	"github.com/zerodha/logf"
 )
 
+// logfGroups returns the fields of the Groups scenarios with dotted keys, as
+// logfmt cannot nest them.
+func logfGroups() []any {
+	return []any{
+		"http.request.method", reqMethod,
+		"http.request.path", reqPath,
+		"http.response.status_code", grpStatusCode,
+		"db.system", grpDBSystem,
+		"db.rows", grpDBRows,
+	}
+}
+
 type logfBench struct {
 	l logf.Logger
 }
@@ -37,6 +50,21 @@ func (b *logfBench) newWithCtx(w io.Writer) logBenchmark {
 	}
 }
 
+func (b *logfBench) newWithGroups(w io.Writer) logBenchmark {
+	l := newLogf(w)
+	l.DefaultFields = logfGroups()
+
+	return &logfBench{
+		l,
+	}
+}
+
+// newWithLevelSwitch returns no switch as logf reads its level from Opts
+// without synchronization.
+func (b *logfBench) newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool)) {
+	return b.new(w), nil
+}
+
 func (b *logfBench) name() string {
 	return "Logf"
 }
@@ -45,6 +73,14 @@ func (b *logfBench) logEvent(msg string) {
 	b.l.Info(msg)
 }
 
+func (b *logfBench) logEventWarn(msg string) {
+	b.l.Warn(msg)
+}
+
+func (b *logfBench) logEventError(msg string) {
+	b.l.Error(msg)
+}
+
 func (b *logfBench) logEventFmt(msg string, args ...any) {
 	b.l.Info(fmt.Sprintf(msg, args...))
 }
@@ -57,6 +93,65 @@ func (b *logfBench) logEventCtxWeak(msg string) {
 	b.logEventCtx(msg)
 }
 
+func (b *logfBench) logEventCtxWarn(msg string) {
+	b.l.Warn(msg, alternatingKeyValuePairs()...)
+}
+
+func (b *logfBench) logEventCtxError(msg string) {
+	b.l.Error(msg, alternatingKeyValuePairs()...)
+}
+
+func (b *logfBench) logEventErrorStack(msg string, err error) {
+	b.l.Error(msg, "error", err, "stack", stackTrace())
+}
+
+func (b *logfBench) logEventKV(msg string, keysAndValues ...any) {
+	b.l.Info(msg, keysAndValues...)
+}
+
+func (b *logfBench) logEventField(msg string, kind fieldKind) {
+	key, value := kind.keyValue()
+	b.l.Info(msg, key, value)
+}
+
+func (b *logfBench) withTraceContext(ctx context.Context) context.Context {
+	l := b.l
+	l.DefaultFields = append(
+		l.DefaultFields[:len(l.DefaultFields):len(l.DefaultFields)],
+		"trace_id", ctxTraceID,
+		"span_id", ctxSpanID,
+	)
+
+	return contextWithLogger(ctx, l)
+}
+
+func (b *logfBench) logEventFromContext(ctx context.Context, msg string) {
+	loggerFromContext[logf.Logger](ctx).Info(msg)
+}
+
+func (b *logfBench) logEventLazy(msg string) {
+	b.l.Info(msg, "digest", lazyDigest{})
+}
+
+func (b *logfBench) logRequest(msg string) {
+	l := b.l
+	l.DefaultFields = append(
+		b.l.DefaultFields[:len(b.l.DefaultFields):len(b.l.DefaultFields)],
+		"request_id", reqID,
+		"method", reqMethod,
+		"path", reqPath,
+		"remote_addr", reqRemoteAddr,
+	)
+
+	for i := 0; i < requestEvents; i++ {
+		l.Info(msg)
+	}
+}
+
+func (b *logfBench) logEventGroups(msg string) {
+	b.l.Info(msg, logfGroups()...)
+}
+
 func (b *logfBench) logDisabled(msg string) {
 	b.l.Debug(msg)
 }
@@ -69,6 +164,16 @@ func (b *logfBench) logDisabledCtx(msg string) {
 	b.l.Debug(msg, alternatingKeyValuePairs()...)
 }
 
+func (b *logfBench) logDisabledCtxGuarded(msg string) {
+	if b.l.Level <= logf.DebugLevel {
+		b.l.Debug(msg, alternatingKeyValuePairs()...)
+	}
+}
+
 func (b *logfBench) logDisabledCtxWeak(msg string) {
 	b.logDisabledCtx(msg)
 }
+
+func (b *logfBench) logDisabledLazy(msg string) {
+	b.l.Debug(msg, "digest", lazyDigest{})
+}
@@ -1,6 +1,7 @@
 package bench
 
 import (
+	"context"
 	"io"
 
 	"github.com/sirupsen/logrus"
@@ -25,12 +26,38 @@ func (b *logrusBench) new(w io.Writer) logBenchmark {
 	}
 }
 
+// newWithCtx returns the Logger of the Entry holding the context fields, which
+// drops them. Keeping the Entry changes the results of every scenario built on
+// newWithCtx, so it is left to a change of its own.
 func (b *logrusBench) newWithCtx(w io.Writer) logBenchmark {
 	return &logrusBench{
 		l: newLogrus(w).WithFields(mapFields()).Logger,
 	}
 }
 
+func (b *logrusBench) newWithGroups(w io.Writer) logBenchmark {
+	l := newLogrus(w)
+
+	return &logrusGroupsBench{
+		logrusBench: logrusBench{l: l},
+		e:           l.WithFields(groupFields()),
+	}
+}
+
+func (b *logrusBench) newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool)) {
+	l := newLogrus(w)
+
+	return &logrusBench{
+		l: l,
+	}, func(debug bool) {
+		if debug {
+			l.SetLevel(logrus.DebugLevel)
+		} else {
+			l.SetLevel(logrus.InfoLevel)
+		}
+	}
+}
+
 func (b *logrusBench) name() string {
 	return "Logrus"
 }
@@ -39,6 +66,14 @@ func (b *logrusBench) logEvent(msg string) {
 	b.l.Info(msg)
 }
 
+func (b *logrusBench) logEventWarn(msg string) {
+	b.l.Warn(msg)
+}
+
+func (b *logrusBench) logEventError(msg string) {
+	b.l.Error(msg)
+}
+
 func (b *logrusBench) logEventFmt(msg string, args ...any) {
 	b.l.Infof(msg, args...)
 }
@@ -51,6 +86,59 @@ func (b *logrusBench) logEventCtxWeak(msg string) {
 	b.logEventCtx(msg)
 }
 
+func (b *logrusBench) logEventCtxWarn(msg string) {
+	b.l.WithFields(mapFields()).Warn(msg)
+}
+
+func (b *logrusBench) logEventCtxError(msg string) {
+	b.l.WithFields(mapFields()).Error(msg)
+}
+
+func (b *logrusBench) logEventErrorStack(msg string, err error) {
+	b.l.WithError(err).WithField("stack", stackTrace()).Error(msg)
+}
+
+func (b *logrusBench) logEventKV(msg string, keysAndValues ...any) {
+	b.l.WithFields(keyValueMap(keysAndValues)).Info(msg)
+}
+
+func (b *logrusBench) logEventField(msg string, kind fieldKind) {
+	key, value := kind.keyValue()
+	b.l.WithField(key, value).Info(msg)
+}
+
+func (b *logrusBench) withTraceContext(ctx context.Context) context.Context {
+	return contextWithLogger(ctx, b.l.WithFields(logrus.Fields{
+		"trace_id": ctxTraceID,
+		"span_id":  ctxSpanID,
+	}))
+}
+
+func (b *logrusBench) logEventFromContext(ctx context.Context, msg string) {
+	loggerFromContext[*logrus.Entry](ctx).Info(msg)
+}
+
+func (b *logrusBench) logEventLazy(msg string) {
+	b.l.WithField("digest", lazyDigest{}).Info(msg)
+}
+
+func (b *logrusBench) logRequest(msg string) {
+	l := b.l.WithFields(logrus.Fields{
+		"request_id":  reqID,
+		"method":      reqMethod,
+		"path":        reqPath,
+		"remote_addr": reqRemoteAddr,
+	})
+
+	for i := 0; i < requestEvents; i++ {
+		l.Info(msg)
+	}
+}
+
+func (b *logrusBench) logEventGroups(msg string) {
+	b.l.WithFields(groupFields()).Info(msg)
+}
+
 func (b *logrusBench) logDisabled(msg string) {
 	b.l.Debug(msg)
 }
@@ -63,6 +151,29 @@ func (b *logrusBench) logDisabledCtx(msg string) {
 	b.l.WithFields(mapFields()).Debug(msg)
 }
 
+func (b *logrusBench) logDisabledCtxGuarded(msg string) {
+	if b.l.IsLevelEnabled(logrus.DebugLevel) {
+		b.l.WithFields(mapFields()).Debug(msg)
+	}
+}
+
 func (b *logrusBench) logDisabledCtxWeak(msg string) {
 	b.logDisabledCtx(msg)
 }
+
+func (b *logrusBench) logDisabledLazy(msg string) {
+	b.l.WithField("digest", lazyDigest{}).Debug(msg)
+}
+
+// logrusGroupsBench is the logger of the AccumulatedGroups scenario. Logrus
+// keeps accumulated fields in an Entry rather than in the Logger, so the
+// event is logged through the Entry holding the groups. The scenario calls no
+// other method.
+type logrusGroupsBench struct {
+	logrusBench
+	e *logrus.Entry
+}
+
+func (b *logrusGroupsBench) logEvent(msg string) {
+	b.e.Info(msg)
+}
@@ -0,0 +1,35 @@
+package bench
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	flag.Parse()
+
+	if *envFile != "" {
+		if err := writeEnv(*envFile); err != nil {
+			fmt.Fprintln(os.Stderr, "writing environment:", err)
+			os.Exit(1)
+		}
+	}
+
+	if *profileDir != "" {
+		runtime.SetMutexProfileFraction(1)
+	}
+
+	code := m.Run()
+
+	if *profileDir != "" {
+		if err := summarizeProfiles(*profileDir, *profileTop); err != nil {
+			fmt.Fprintln(os.Stderr, "summarizing profiles:", err)
+			code = 1
+		}
+	}
+
+	os.Exit(code)
+}
@@ -0,0 +1,224 @@
+package bench
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+var workloadMix = flag.String(
+	"mix",
+	"85,12,2,1",
+	"percentages of disabled debug, info, warn and error events in BenchmarkMixedWorkload",
+)
+
+// mixOp is a kind of call made by the mixed workload.
+type mixOp int
+
+const (
+	mixDebug mixOp = iota // disabled debug event
+	mixInfo               // info event
+	mixWarn               // warn event with contextual fields
+	mixError              // error event with an error and a stack trace
+	numMixOps
+)
+
+// mixLength is the length of the sequence of calls that every goroutine of
+// BenchmarkMixedWorkload cycles through.
+const mixLength = 1000
+
+func (op mixOp) log(l logBenchmark) {
+	switch op {
+	case mixDebug:
+		l.logDisabled(logMsg)
+	case mixInfo:
+		l.logEvent(logMsg)
+	case mixWarn:
+		l.logEventCtxWarn(logMsg)
+	case mixError:
+		l.logEventErrorStack(logMsg, ctxErr)
+	}
+}
+
+// BenchmarkMixedWorkload tests the cost of a realistic mix of calls, where
+// most debug events are disabled and a few events are logged at the levels
+// that are alerted on. The mix is set by -mix, and the calls are made in the
+// same pseudo-random order for every library, so ns/op is the average cost
+// of a call in a real service.
+func BenchmarkMixedWorkload(b *testing.B) {
+	b.Logf("Log a realistic mix of disabled debug, info, warn and error events")
+
+	weights, err := parseMix(*workloadMix)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	seq := mixSequence(weights, mixLength)
+
+	for _, v := range loggers {
+		b.Run(v.name(), func(b *testing.B) {
+			out := &blackhole{}
+			l := v.new(out)
+
+			var events atomic.Uint64
+
+			b.ResetTimer()
+
+			runParallel(b, func(pb *testing.PB) {
+				var n uint64
+
+				for i := 0; pb.Next(); i++ {
+					op := seq[i%len(seq)]
+					op.log(l)
+
+					if op != mixDebug {
+						n++
+					}
+				}
+
+				events.Add(n)
+			})
+
+			reportWrites(b, out, events.Load(), nil)
+		})
+	}
+}
+
+// parseMix parses the comma-separated percentages of -mix into the weight
+// of each mixOp.
+func parseMix(s string) ([numMixOps]int, error) {
+	var weights [numMixOps]int
+
+	parts := strings.Split(s, ",")
+	if len(parts) != len(weights) {
+		return weights, fmt.Errorf(
+			"-mix needs %d comma-separated percentages for debug, info, warn and error, got %q",
+			len(weights), s,
+		)
+	}
+
+	total := 0
+
+	for i, p := range parts {
+		w, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil || w < 0 {
+			return weights, fmt.Errorf("-mix: invalid percentage %q", p)
+		}
+
+		weights[i] = w
+		total += w
+	}
+
+	if total == 0 {
+		return weights, errors.New("-mix: percentages add up to zero")
+	}
+
+	return weights, nil
+}
+
+// mixSequence returns n calls in which every op appears in proportion to its
+// weight, shuffled with a fixed seed so that the sequence is the same on
+// every run. Rounding leftovers go to the disabled debug events.
+func mixSequence(weights [numMixOps]int, n int) []mixOp {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+
+	seq := make([]mixOp, 0, n)
+
+	for op := mixInfo; op < numMixOps; op++ {
+		for i := 0; i < weights[op]*n/total; i++ {
+			seq = append(seq, op)
+		}
+	}
+
+	for len(seq) < n {
+		seq = append(seq, mixDebug)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	rng.Shuffle(len(seq), func(i, j int) { seq[i], seq[j] = seq[j], seq[i] })
+
+	return seq
+}
+
+func TestMixSequence(t *testing.T) {
+	weights, err := parseMix("85,12,2,1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seq := mixSequence(weights, mixLength)
+
+	var counts [numMixOps]int
+	for _, op := range seq {
+		counts[op]++
+	}
+
+	if want := [numMixOps]int{850, 120, 20, 10}; counts != want {
+		t.Errorf("Expected %v calls of each kind, got %v", want, counts)
+	}
+
+	again := mixSequence(weights, mixLength)
+	for i := range seq {
+		if seq[i] != again[i] {
+			t.Fatalf("Sequence is not deterministic at call %d", i)
+		}
+	}
+
+	for _, mix := range []string{"85,12,3", "85,12,2,x", "85,12,-2,1", "0,0,0,0"} {
+		if _, err := parseMix(mix); err == nil {
+			t.Errorf("%q: expected an error", mix)
+		}
+	}
+}
+
+// TestMixedLevelOutput checks the level and fields of the warn and error
+// events of the mixed workload.
+func TestMixedLevelOutput(t *testing.T) {
+	for _, v := range loggers {
+		t.Run(v.name(), func(t *testing.T) {
+			var buf bytes.Buffer
+			l := v.new(&buf)
+
+			l.logEventCtxWarn(logMsg)
+			checkLevel(t, buf.Bytes(), "warn")
+
+			buf.Reset()
+			l.logEventErrorStack(logMsg, ctxErr)
+			e := checkLevel(t, buf.Bytes(), "error")
+
+			if p := e.check("error", ctxErr.Error()); p != "" {
+				t.Error(p)
+			}
+
+			if stack, _ := e.fields["stack"].(string); !strings.Contains(stack, "TestMixedLevelOutput") {
+				t.Errorf("Expected a stack trace of the caller, got %q", abbrev(e.fields["stack"]))
+			}
+		})
+	}
+}
+
+// checkLevel decodes the single event in out and checks that it was logged
+// at the given level.
+func checkLevel(t *testing.T, out []byte, level string) logEvent {
+	t.Helper()
+
+	e, err := parseEvent(bytes.TrimSuffix(out, []byte("\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p := e.checkLevel(level); p != "" {
+		t.Error(p)
+	}
+
+	return e
+}
@@ -0,0 +1,56 @@
+package bench
+
+import (
+	"bytes"
+	"testing"
+)
+
+// payload is the message and fields logged by one of the payload scenarios.
+type payload struct {
+	scenario      string
+	msg           string
+	keysAndValues []any
+}
+
+// payloads returns the payload of every payload scenario, sized by the
+// payload flags.
+func payloads() []payload {
+	return []payload{
+		{"EventLargeMsg", sizedMsg(*largeMsgSize), nil},
+		{"EventHugeMsg", sizedMsg(*hugeMsgSize), nil},
+		{"EventManyFields", logMsg, numberedFields(*manyFields)},
+		{"EventNestedMap", logMsg, []any{"nested", nestedMap(*nestedDepth)}},
+		{"EventEscaping", escapeMsg, escapeFields},
+	}
+}
+
+// TestPayloadOutput checks the output of every library in the payload
+// scenarios. It fails on problems that are not in knownOutputProblems, and
+// on known problems that no longer occur, so that the list stays current.
+func TestPayloadOutput(t *testing.T) {
+	for _, p := range payloads() {
+		t.Run(p.scenario, func(t *testing.T) {
+			for _, v := range loggers {
+				t.Run(v.name(), func(t *testing.T) {
+					var buf bytes.Buffer
+					v.new(&buf).logEventKV(p.msg, p.keysAndValues...)
+
+					problems := checkOutput(buf.Bytes(), p.msg, p.keysAndValues)
+
+					known, ok := knownOutputProblems[p.scenario+"/"+v.name()]
+					if !ok {
+						for _, problem := range problems {
+							t.Error(problem)
+						}
+
+						return
+					}
+
+					if len(problems) == 0 {
+						t.Errorf("Known problem no longer occurs: %s", known)
+					}
+				})
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package bench
 
 import (
+	"context"
 	"io"
 	"time"
 
@@ -28,6 +29,31 @@ func phusFields(e *log.Entry) *log.Entry {
 	return e
 }
 
+func phusHTTP() log.Context {
+	return log.NewContext(nil).
+		Dict("request", log.NewContext(nil).
+			Str("method", reqMethod).
+			Str("path", reqPath).
+			Value()).
+		Dict("response", log.NewContext(nil).
+			Int("status_code", grpStatusCode).
+			Value()).
+		Value()
+}
+
+func phusDB() log.Context {
+	return log.NewContext(nil).
+		Str("system", grpDBSystem).
+		Int("rows", grpDBRows).
+		Value()
+}
+
+// phusDigest adds the expensive digest field, which Func only computes when
+// the entry is enabled.
+func phusDigest(e *log.Entry) {
+	e.Str("digest", expensiveDigest())
+}
+
 func newPhusLog(w io.Writer) log.Logger {
 	l := log.Logger{
 		Level:      log.InfoLevel,
@@ -61,6 +87,32 @@ func (b *phusLogBench) newWithCtx(w io.Writer) logBenchmark {
 	}
 }
 
+func (b *phusLogBench) newWithGroups(w io.Writer) logBenchmark {
+	l := newPhusLog(w)
+	l.Context = log.NewContext(nil).
+		Dict("http", phusHTTP()).
+		Dict("db", phusDB()).
+		Value()
+
+	return &phusLogBench{
+		l,
+	}
+}
+
+func (b *phusLogBench) newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool)) {
+	l := &phusLogBench{
+		l: newPhusLog(w),
+	}
+
+	return l, func(debug bool) {
+		if debug {
+			l.l.SetLevel(log.DebugLevel)
+		} else {
+			l.l.SetLevel(log.InfoLevel)
+		}
+	}
+}
+
 func (b *phusLogBench) name() string {
 	return "Phuslog"
 }
@@ -69,6 +121,14 @@ func (b *phusLogBench) logEvent(msg string) {
 	b.l.Info().Msg(msg)
 }
 
+func (b *phusLogBench) logEventWarn(msg string) {
+	b.l.Warn().Msg(msg)
+}
+
+func (b *phusLogBench) logEventError(msg string) {
+	b.l.Error().Msg(msg)
+}
+
 func (b *phusLogBench) logEventFmt(msg string, args ...any) {
 	b.l.Info().Msgf(msg, args...)
 }
@@ -81,6 +141,99 @@ func (b *phusLogBench) logEventCtxWeak(msg string) {
 	b.l.Info().Fields(mapFields()).Msg(msg)
 }
 
+func (b *phusLogBench) logEventCtxWarn(msg string) {
+	phusFields(b.l.Warn()).Msg(msg)
+}
+
+func (b *phusLogBench) logEventCtxError(msg string) {
+	phusFields(b.l.Error()).Msg(msg)
+}
+
+func (b *phusLogBench) logEventErrorStack(msg string, err error) {
+	b.l.Error().Err(err).Str("stack", stackTrace()).Msg(msg)
+}
+
+func (b *phusLogBench) logEventKV(msg string, keysAndValues ...any) {
+	b.l.Info().KeysAndValues(keysAndValues...).Msg(msg)
+}
+
+func (b *phusLogBench) logEventField(msg string, kind fieldKind) {
+	e := b.l.Info()
+
+	switch kind {
+	case fieldInt:
+		e.Int("bytes", ctxBodyBytes)
+	case fieldString:
+		e.Str("request", ctxRequest)
+	case fieldFloat64:
+		e.Float64("elapsed_time_ms", ctxTimeElapsedMs)
+	case fieldBool:
+		e.Bool("cache_hit", ctxCacheHit)
+	case fieldTime:
+		e.Time("now", ctxTime)
+	case fieldDuration:
+		e.Dur("timeout", ctxTimeout)
+	case fieldError:
+		e.Err(ctxErr)
+	case fieldStrings:
+		e.Strs("months", ctxMonths)
+	case fieldInts:
+		e.Ints("primes", ctxFirst10Primes)
+	case fieldObject:
+		e.Object("user", ctxUser)
+	case fieldObjectReflect:
+		e.Interface("user", ctxPlainUser)
+	case fieldObjects:
+		// Phuslog has no array marshaler, so BenchmarkFieldObjects skips it.
+		e.Any("users", ctxUsers)
+	case fieldObjectsReflect:
+		e.Interface("users", ctxPlainUsers)
+	case fieldMap:
+		e.Interface("labels", ctxLabels)
+	}
+
+	e.Msg(msg)
+}
+
+func (b *phusLogBench) withTraceContext(ctx context.Context) context.Context {
+	l := b.l
+	l.Context = log.NewContext(b.l.Context[:len(b.l.Context):len(b.l.Context)]).
+		Str("trace_id", ctxTraceID).
+		Str("span_id", ctxSpanID).
+		Value()
+
+	return contextWithLogger(ctx, &l)
+}
+
+func (b *phusLogBench) logEventFromContext(ctx context.Context, msg string) {
+	loggerFromContext[*log.Logger](ctx).Info().Msg(msg)
+}
+
+func (b *phusLogBench) logEventLazy(msg string) {
+	b.l.Info().Func(phusDigest).Msg(msg)
+}
+
+func (b *phusLogBench) logRequest(msg string) {
+	l := b.l
+	l.Context = log.NewContext(b.l.Context[:len(b.l.Context):len(b.l.Context)]).
+		Str("request_id", reqID).
+		Str("method", reqMethod).
+		Str("path", reqPath).
+		Str("remote_addr", reqRemoteAddr).
+		Value()
+
+	for i := 0; i < requestEvents; i++ {
+		l.Info().Msg(msg)
+	}
+}
+
+func (b *phusLogBench) logEventGroups(msg string) {
+	b.l.Info().
+		Dict("http", phusHTTP()).
+		Dict("db", phusDB()).
+		Msg(msg)
+}
+
 func (b *phusLogBench) logDisabled(msg string) {
 	b.l.Debug().Msg(msg)
 }
@@ -93,6 +246,16 @@ func (b *phusLogBench) logDisabledCtx(msg string) {
 	phusFields(b.l.Debug()).Msg(msg)
 }
 
+func (b *phusLogBench) logDisabledCtxGuarded(msg string) {
+	if b.l.Level <= log.DebugLevel {
+		phusFields(b.l.Debug()).Msg(msg)
+	}
+}
+
 func (b *phusLogBench) logDisabledCtxWeak(msg string) {
 	b.l.Debug().Fields(mapFields()).Msg(msg)
 }
+
+func (b *phusLogBench) logDisabledLazy(msg string) {
+	b.l.Debug().Func(phusDigest).Msg(msg)
+}
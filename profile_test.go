@@ -0,0 +1,177 @@
+package bench
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+var (
+	profileDir = flag.String(
+		"profile-dir",
+		"",
+		"capture CPU, heap and mutex profiles of each sub-benchmark in this directory",
+	)
+	profileTop = flag.Int(
+		"profile-top",
+		10,
+		"number of hotspots listed for each profile in the summary written to -profile-dir",
+	)
+)
+
+var (
+	profiledMu sync.Mutex
+	// profiled holds the path prefix of the profiles of every sub-benchmark,
+	// in the order they first ran.
+	profiled []string
+)
+
+// profiles captures the profiles of a single sub-benchmark run. The heap and
+// mutex profiles are cumulative, so a snapshot is taken before the run and
+// subtracted from the one taken after it by summarizeProfiles.
+type profiles struct {
+	prefix string
+	cpu    *os.File
+}
+
+// startProfiles starts capturing the profiles of the running sub-benchmark
+// under -profile-dir. Repeated runs of the same sub-benchmark overwrite the
+// profiles, keeping the ones from the final run with the largest b.N.
+func startProfiles(b *testing.B) *profiles {
+	p := &profiles{
+		prefix: filepath.Join(*profileDir, filepath.FromSlash(b.Name())),
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p.prefix), 0o755); err != nil {
+		b.Fatal(err)
+	}
+
+	profiledMu.Lock()
+	if len(profiled) == 0 || profiled[len(profiled)-1] != p.prefix {
+		profiled = append(profiled, p.prefix)
+	}
+	profiledMu.Unlock()
+
+	p.snapshot(b, "base")
+
+	f, err := os.Create(p.prefix + ".cpu.pprof")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	if err := pprof.StartCPUProfile(f); err != nil {
+		f.Close()
+		b.Fatal(err)
+	}
+
+	p.cpu = f
+
+	return p
+}
+
+func (p *profiles) stop(b *testing.B) {
+	pprof.StopCPUProfile()
+
+	if err := p.cpu.Close(); err != nil {
+		b.Fatal(err)
+	}
+
+	p.snapshot(b, "after")
+}
+
+// snapshot writes the current heap and mutex profiles with the given suffix.
+func (p *profiles) snapshot(b *testing.B, suffix string) {
+	runtime.GC()
+
+	for _, name := range []string{"heap", "mutex"} {
+		f, err := os.Create(p.prefix + "." + name + "." + suffix + ".pprof")
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		err = pprof.Lookup(name).WriteTo(f, 0)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// summarizeProfiles turns the heap and mutex snapshots of every profiled
+// sub-benchmark into profiles of that run alone, then writes the top hotspots
+// of each profile to summary.txt in dir. The summary only counts samples from
+// the goroutines started by b.RunParallel, leaving out the cost of capturing
+// the profiles.
+func summarizeProfiles(dir string, top int) error {
+	var summary bytes.Buffer
+
+	for _, prefix := range profiled {
+		for _, name := range []string{"heap", "mutex"} {
+			base := prefix + "." + name + ".base.pprof"
+			after := prefix + "." + name + ".after.pprof"
+
+			out, err := pprofTool("-proto", "-base", base, after)
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(prefix+"."+name+".pprof", out, 0o644); err != nil {
+				return err
+			}
+
+			os.Remove(base)
+			os.Remove(after)
+		}
+
+		rel, err := filepath.Rel(dir, prefix)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range []string{"cpu", "heap", "mutex"} {
+			args := []string{
+				"-top",
+				"-nodecount=" + strconv.Itoa(top),
+				"-focus=" + regexp.QuoteMeta("testing.(*B).RunParallel"),
+			}
+			if name == "heap" {
+				args = append(args, "-sample_index=alloc_space")
+			}
+
+			out, err := pprofTool(append(args, prefix+"."+name+".pprof")...)
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(&summary, "==> %s (%s)\n%s\n", filepath.ToSlash(rel), name, out)
+		}
+	}
+
+	return os.WriteFile(filepath.Join(dir, "summary.txt"), summary.Bytes(), 0o644)
+}
+
+func pprofTool(args ...string) ([]byte, error) {
+	cmd := exec.Command("go", append([]string{"tool", "pprof"}, args...)...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go tool pprof %v: %w: %s", args, err, stderr.String())
+	}
+
+	return out, nil
+}
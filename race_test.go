@@ -0,0 +1,7 @@
+//go:build race
+
+package bench
+
+// raceEnabled reports whether the race detector is on. It adds allocations
+// of its own, so allocation counts are not checked under it.
+const raceEnabled = true
@@ -0,0 +1,48 @@
+package bench
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestRequestOutput checks that every event logged by a child logger carries
+// the request fields, and that deriving it leaves the parent logger alone.
+func TestRequestOutput(t *testing.T) {
+	fields := []any{
+		"request_id", reqID,
+		"method", reqMethod,
+		"path", reqPath,
+		"remote_addr", reqRemoteAddr,
+	}
+
+	for _, v := range loggers {
+		t.Run(v.name(), func(t *testing.T) {
+			var buf bytes.Buffer
+			l := v.new(&buf)
+			l.logRequest(logMsg)
+
+			lines := bytes.SplitAfter(buf.Bytes(), []byte("\n"))
+			if len(lines) != requestEvents+1 || len(lines[requestEvents]) != 0 {
+				t.Fatalf("Expected %d events, got %q", requestEvents, buf.String())
+			}
+
+			for _, line := range lines[:requestEvents] {
+				for _, p := range checkOutput(line, logMsg, fields) {
+					t.Error(p)
+				}
+			}
+
+			buf.Reset()
+			l.logEvent(logMsg)
+
+			e, err := parseEvent(bytes.TrimSuffix(buf.Bytes(), []byte("\n")))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, ok := e.fields["request_id"]; ok {
+				t.Error("Request fields were added to the parent logger")
+			}
+		})
+	}
+}
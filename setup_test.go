@@ -1,9 +1,20 @@
 package bench
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"flag"
+	"fmt"
 	"io"
+	"math"
+	"runtime"
+	"strings"
 	"sync/atomic"
+	"testing"
 	"time"
 )
 
@@ -15,6 +26,10 @@ type user struct {
 
 type users []user
 
+// plainUser has the same fields as user but implements none of the marshaler
+// interfaces, so libraries have to fall back to reflection to encode it.
+type plainUser user
+
 var (
 	ctxBodyBytes     = 123456789
 	ctxRequest       = "GET /icons/ubuntu-logo.png HTTP/1.1"
@@ -53,8 +68,107 @@ var (
 	}
 	ctxFirst10Primes = []int{2, 3, 5, 7, 11, 13, 17, 23, 29, 31}
 	ctxErr           = errors.New("failed to open file: /home/dev/new.txt")
+	ctxPlainUser     = plainUser(ctxUser)
+	ctxPlainUsers    = plainUsers(ctxUsers)
+	ctxCacheHit      = true
+	ctxTimeout       = 30 * time.Second
+	ctxLabels        = map[string]string{
+		"env":     "production",
+		"region":  "eu-west-1",
+		"service": "checkout",
+	}
+)
+
+func plainUsers(uu users) []plainUser {
+	plain := make([]plainUser, len(uu))
+	for i, u := range uu {
+		plain[i] = plainUser(u)
+	}
+
+	return plain
+}
+
+// fieldKind selects the single field logged by the field type scenarios.
+type fieldKind int
+
+const (
+	fieldInt fieldKind = iota
+	fieldString
+	fieldFloat64
+	fieldBool
+	fieldTime
+	fieldDuration
+	fieldError
+	fieldStrings
+	fieldInts
+	fieldObject
+	fieldObjectReflect
+	fieldObjects
+	fieldObjectsReflect
+	fieldMap
+	numFieldKinds
 )
 
+var fieldKindNames = [numFieldKinds]string{
+	"Int",
+	"String",
+	"Float64",
+	"Bool",
+	"Time",
+	"Duration",
+	"Error",
+	"Strings",
+	"Ints",
+	"Object",
+	"ObjectReflect",
+	"Objects",
+	"ObjectsReflect",
+	"Map",
+}
+
+// String returns the name of k as used by its benchmark, such as "Int" for
+// BenchmarkFieldInt.
+func (k fieldKind) String() string {
+	return fieldKindNames[k]
+}
+
+// keyValue returns the key and value logged for k. Libraries without a
+// strongly typed API log these directly.
+func (k fieldKind) keyValue() (string, any) {
+	switch k {
+	case fieldInt:
+		return "bytes", ctxBodyBytes
+	case fieldString:
+		return "request", ctxRequest
+	case fieldFloat64:
+		return "elapsed_time_ms", ctxTimeElapsedMs
+	case fieldBool:
+		return "cache_hit", ctxCacheHit
+	case fieldTime:
+		return "now", ctxTime
+	case fieldDuration:
+		return "timeout", ctxTimeout
+	case fieldError:
+		return "error", ctxErr
+	case fieldStrings:
+		return "months", ctxMonths
+	case fieldInts:
+		return "primes", ctxFirst10Primes
+	case fieldObject:
+		return "user", ctxUser
+	case fieldObjectReflect:
+		return "user", ctxPlainUser
+	case fieldObjects:
+		return "users", ctxUsers
+	case fieldObjectsReflect:
+		return "users", ctxPlainUsers
+	case fieldMap:
+		return "labels", ctxLabels
+	}
+
+	panic(fmt.Sprintf("unknown field kind %d", k))
+}
+
 func mapFields() map[string]any {
 	return map[string]any{
 		"bytes":           ctxBodyBytes,
@@ -83,18 +197,228 @@ func alternatingKeyValuePairs() []any {
 	}
 }
 
+// groupFields returns the fields of the Groups scenarios nested as maps, in
+// the http.request.*, http.response.* and db.* groups, for the libraries
+// without native grouping.
+func groupFields() map[string]any {
+	return map[string]any{
+		"http": map[string]any{
+			"request": map[string]any{
+				"method": reqMethod,
+				"path":   reqPath,
+			},
+			"response": map[string]any{
+				"status_code": grpStatusCode,
+			},
+		},
+		"db": map[string]any{
+			"system": grpDBSystem,
+			"rows":   grpDBRows,
+		},
+	}
+}
+
+// groupKeyValuePairs returns the groups of groupFields as alternating
+// key-value pairs.
+func groupKeyValuePairs() []any {
+	groups := groupFields()
+
+	return []any{"http", groups["http"], "db", groups["db"]}
+}
+
+// stackTrace returns the stack of its caller with one frame per line, for
+// the libraries without a stack trace field of their own.
+func stackTrace() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	var sb strings.Builder
+
+	for {
+		f, more := frames.Next()
+		fmt.Fprintf(&sb, "%s (%s:%d)", f.Function, f.File, f.Line)
+
+		if !more {
+			break
+		}
+
+		sb.WriteByte('\n')
+	}
+
+	return sb.String()
+}
+
+// keyValueMap converts alternating key-value pairs into a map for the
+// libraries that take fields as a map.
+func keyValueMap(keysAndValues []any) map[string]any {
+	m := make(map[string]any, len(keysAndValues)/2)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		m[keysAndValues[i].(string)] = keysAndValues[i+1]
+	}
+
+	return m
+}
+
+var (
+	largeMsgSize = flag.Int("large-msg-size", 4<<10, "size in bytes of the message logged by BenchmarkEventLargeMsg")
+	hugeMsgSize  = flag.Int("huge-msg-size", 64<<10, "size in bytes of the message logged by BenchmarkEventHugeMsg")
+	manyFields   = flag.Int("many-fields", 100, "number of fields logged by BenchmarkEventManyFields")
+	nestedDepth  = flag.Int("nested-depth", 10, "depth of the map logged by BenchmarkEventNestedMap")
+)
+
+// sizedMsg repeats logMsg until the message is exactly size bytes long.
+func sizedMsg(size int) string {
+	return strings.Repeat(logMsg+" ", size/(len(logMsg)+1)+1)[:size]
+}
+
+// numberedFields returns n key-value pairs alternating between string and
+// integer values.
+func numberedFields(n int) []any {
+	kv := make([]any, 0, 2*n)
+	for i := 0; i < n; i++ {
+		key := fmt.Sprintf("field_%03d", i)
+		if i%2 == 0 {
+			kv = append(kv, key, fmt.Sprintf("value %d", i))
+		} else {
+			kv = append(kv, key, i)
+		}
+	}
+
+	return kv
+}
+
+// nestedMap returns a map with a child map nested depth levels deep.
+func nestedMap(depth int) map[string]any {
+	m := map[string]any{
+		"depth": depth,
+		"name":  ctxUser.Name,
+	}
+	if depth > 1 {
+		m["child"] = nestedMap(depth - 1)
+	}
+
+	return m
+}
+
+var (
+	escapeMsg    = "Quote \" \\ backslash, tab \t, newline \n, NUL \x00, invalid \xff\xfe, emoji \U0001F98A"
+	escapeFields = []any{
+		"quotes", `She said "it's <fine> & done" \o/`,
+		"control", "\x00\x01\x07\b\f\n\r\t\x1b[31mred\x1b[0m\x7f",
+		"invalid_utf8", "bad \xff\xfe\xfd bytes and a truncated \xe2\x82",
+		"emoji", "\U0001F98A jumps over \U0001F436 \U0001F468\u200D\U0001F469\u200D\U0001F467",
+		"separators", "line\u2028paragraph\u2029end",
+		"unicode", "Ünïcödé ✓ 日本語",
+	}
+)
+
+var (
+	ctxTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	ctxSpanID  = "00f067aa0ba902b7"
+)
+
+// The fields of a request that logRequest derives a child logger with.
+var (
+	reqID         = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	reqMethod     = "GET"
+	reqPath       = "/api/v1/users/42"
+	reqRemoteAddr = "192.0.2.1:54321"
+)
+
+// The fields of the Groups scenarios besides the request method and path.
+var (
+	grpStatusCode = 200
+	grpDBSystem   = "postgresql"
+	grpDBRows     = 42
+)
+
+// requestEvents is the number of events logged by logRequest.
+const requestEvents = 3
+
+// expensiveCalls counts the calls to expensiveDigest, revealing whether a
+// library computes fields that it ends up discarding.
+var expensiveCalls atomic.Uint64
+
+// expensiveDigest simulates a field that is costly to compute, such as a
+// checksum of a request body.
+func expensiveDigest() string {
+	expensiveCalls.Add(1)
+
+	sum := sha256.Sum256([]byte(logMsg))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// lazyDigest defers expensiveDigest until a library encodes the field. It
+// implements fmt.Stringer, json.Marshaler and slog.LogValuer, so that every
+// library can take it through its deferred mechanism.
+type lazyDigest struct{}
+
+func (lazyDigest) String() string {
+	return expensiveDigest()
+}
+
+func (lazyDigest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(expensiveDigest())
+}
+
+type loggerKey struct{}
+
+// contextWithLogger stores a logger in ctx for libraries that do not provide
+// a context helper of their own.
+func contextWithLogger[T any](ctx context.Context, l T) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+func loggerFromContext[T any](ctx context.Context) T {
+	l, _ := ctx.Value(loggerKey{}).(T)
+	return l
+}
+
 var (
 	logMsg     = "The quick brown fox jumps over the lazy dog"
 	logMsgFmt  = "User: %s, Age: %d, Height: %.2f cm, Married: %t, Birthdate: %02d-%s-%d"
-	logMsgArgs = []any{
-		"Alice",
-		30,
-		175.5,
-		true,
-		time.Date(1992, time.January, 15, 0, 0, 0, 0, time.UTC),
-	}
+	logMsgArgs = []any{"Alice", 30, 175.5, true, 15, time.January, 1992}
 )
 
+// fmtFixture is a format string along with arguments that match its verbs.
+type fmtFixture struct {
+	format string
+	args   []any
+}
+
+// fmtFixtures are checked against the message of every library by
+// TestEventFmtOutput. The first one is used by the Fmt scenarios.
+var fmtFixtures = []fmtFixture{
+	{logMsgFmt, logMsgArgs},
+	{
+		"%s %q took %v (%d bytes, %.1f%% cache hits)",
+		[]any{"GET", "/api/v1/users", 150 * time.Millisecond, 2048, 93.5},
+	},
+	{
+		"Retry %d/%d for job %x failed: %v",
+		[]any{3, 5, 48879, errors.New("connection reset by peer")},
+	},
+	{
+		"User %+v logged in from %v with roles %v",
+		[]any{plainUser{Name: "Alice", Age: 30}, [4]byte{192, 0, 2, 1}, []string{"admin", "editor"}},
+	},
+	{
+		"Padded %-6s|%6s|%06.2f|%+d|%#o|%e",
+		[]any{"left", "right", 3.14159, 42, 8, 123456.789},
+	},
+}
+
+// checkFmtFixture returns an error if the verbs in format do not match args,
+// which fmt reports with %!verb(...) and %!(MISSING) or %!(EXTRA ...).
+func checkFmtFixture(format string, args []any) error {
+	if msg := fmt.Sprintf(format, args...); strings.Contains(msg, "%!") {
+		return fmt.Errorf("verbs in %q do not match the arguments: %q", format, msg)
+	}
+
+	return nil
+}
+
 var loggers = []logBenchmark{
 	&zerologBench{},
 	&phusLogBench{},
@@ -108,29 +432,256 @@ var loggers = []logBenchmark{
 	&logfBench{},
 }
 
+// scenario describes one of the core benchmark scenarios for the modes that
+// run every scenario against every library.
+type scenario struct {
+	name    string
+	withCtx bool
+	log     func(l logBenchmark)
+}
+
+var scenarios = []scenario{
+	{"Event", false, func(l logBenchmark) { l.logEvent(logMsg) }},
+	{"Disabled", false, func(l logBenchmark) { l.logDisabled(logMsg) }},
+	{"EventFmt", false, func(l logBenchmark) { l.logEventFmt(logMsgFmt, logMsgArgs...) }},
+	{"DisabledFmt", false, func(l logBenchmark) { l.logDisabledFmt(logMsgFmt, logMsgArgs...) }},
+	{"EventWarn", false, func(l logBenchmark) { l.logEventWarn(logMsg) }},
+	{"EventError", false, func(l logBenchmark) { l.logEventError(logMsg) }},
+	{"EventCtx", false, func(l logBenchmark) { l.logEventCtx(logMsg) }},
+	{"EventCtxWarn", false, func(l logBenchmark) { l.logEventCtxWarn(logMsg) }},
+	{"EventCtxError", false, func(l logBenchmark) { l.logEventCtxError(logMsg) }},
+	{"DisabledCtx", false, func(l logBenchmark) { l.logDisabledCtx(logMsg) }},
+	{"DisabledCtxGuarded", false, func(l logBenchmark) { l.logDisabledCtxGuarded(logMsg) }},
+	{"EventCtxWeak", true, func(l logBenchmark) { l.logEventCtxWeak(logMsg) }},
+	{"DisabledCtxWeak", true, func(l logBenchmark) { l.logDisabledCtxWeak(logMsg) }},
+	{"EventAccumulatedCtx", true, func(l logBenchmark) { l.logEvent(logMsg) }},
+	{"DisabledAccumulatedCtx", true, func(l logBenchmark) { l.logDisabled(logMsg) }},
+}
+
+// new creates the logger used by the scenario for the given library.
+func (s scenario) new(v logBenchmark, w io.Writer) logBenchmark {
+	if s.withCtx {
+		return v.newWithCtx(w)
+	}
+
+	return v.new(w)
+}
+
+// blackhole discards everything written to it. It is the sink of the timed
+// loops, so it only keeps a few atomic counters: the writes, the bytes and
+// the lines written, and the events torn by interleaved writes.
+//
+// A write that starts with the first byte of an event, learned from the
+// first write, starts an event. It is counted as torn when another event has
+// been started but its line not terminated yet, which only happens when a
+// library splits its events across several writes and they interleave.
 type blackhole struct {
 	count uint64
+	bytes uint64
+	lines uint64
+	torn  uint64
+	// start is the first byte of an event plus one, or zero until the first
+	// write, and open the number of events started by a write that did not
+	// terminate their line.
+	start uint32
+	open  int64
 }
 
 func (s *blackhole) WriteCount() uint64 {
 	return atomic.LoadUint64(&s.count)
 }
 
+func (s *blackhole) ByteCount() uint64 {
+	return atomic.LoadUint64(&s.bytes)
+}
+
+func (s *blackhole) LineCount() uint64 {
+	return atomic.LoadUint64(&s.lines)
+}
+
+func (s *blackhole) TornCount() uint64 {
+	return atomic.LoadUint64(&s.torn)
+}
+
 func (s *blackhole) Write(p []byte) (int, error) {
 	atomic.AddUint64(&s.count, 1)
+
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	atomic.AddUint64(&s.bytes, uint64(len(p)))
+	atomic.AddUint64(&s.lines, uint64(bytes.Count(p, newline)))
+
+	start := atomic.LoadUint32(&s.start)
+	if start == 0 {
+		atomic.CompareAndSwapUint32(&s.start, 0, uint32(p[0])+1)
+		start = atomic.LoadUint32(&s.start)
+	}
+
+	startsEvent := uint32(p[0])+1 == start
+	endsLine := p[len(p)-1] == '\n'
+
+	switch {
+	case startsEvent && endsLine:
+		if atomic.LoadInt64(&s.open) > 0 {
+			atomic.AddUint64(&s.torn, 1)
+		}
+	case startsEvent:
+		if atomic.AddInt64(&s.open, 1) > 1 {
+			atomic.AddUint64(&s.torn, 1)
+		}
+	case endsLine:
+		atomic.AddInt64(&s.open, -1)
+	}
+
 	return len(p), nil
 }
 
+var newline = []byte("\n")
+
+// reportWrites checks that the timed loop logged the given number of events
+// to out, one line each, and reports how they were written: writes/event,
+// bytes/event and interleave-errors, the events torn by interleaved writes.
+// It also sets the bytes written per operation, so that the benchmark
+// reports MB/s.
+//
+// The problems found in the output of a single event before the run are
+// logged and their number reported as output-errors. A line count that
+// differs from the number of events fails the benchmark, unless the library
+// is known to break its output in this scenario, in which case it is one
+// more problem.
+func reportWrites(b *testing.B, out *blackhole, events uint64, problems []string) {
+	b.Helper()
+
+	if lines := out.LineCount(); lines != events {
+		mismatch := fmt.Sprintf(
+			"Mismatch in logged line count. Expected: %d, Actual: %d",
+			events,
+			lines,
+		)
+
+		if _, ok := knownOutputProblem(b); !ok {
+			b.Fatal(mismatch)
+		}
+
+		problems = append(problems, mismatch)
+	}
+
+	for _, p := range problems {
+		b.Log(p)
+	}
+
+	b.ReportMetric(float64(len(problems)), "output-errors")
+
+	if events == 0 {
+		return
+	}
+
+	b.ReportMetric(float64(out.WriteCount())/float64(events), "writes/event")
+	b.ReportMetric(float64(out.ByteCount())/float64(events), "bytes/event")
+	b.ReportMetric(float64(out.TornCount()), "interleave-errors")
+	b.SetBytes(int64(math.Round(float64(out.ByteCount()) / float64(b.N))))
+}
+
+// runParallel runs body with b.RunParallel, capturing profiles of the run
+// when -profile-dir is set and reporting its contention when -contention is
+// set, for operations that log a single event.
+func runParallel(b *testing.B, body func(*testing.PB)) {
+	runParallelEvents(b, 1, body)
+}
+
+// runParallelEvents is runParallel for operations that log eventsPerOp
+// events each, so that contention is reported per event.
+func runParallelEvents(b *testing.B, eventsPerOp int, body func(*testing.PB)) {
+	if *profileDir == "" && !*contention {
+		b.RunParallel(body)
+		return
+	}
+
+	b.StopTimer()
+
+	var p *profiles
+	if *profileDir != "" {
+		p = startProfiles(b)
+	}
+
+	var c *contentionMeter
+	if *contention {
+		c = startContention()
+	}
+
+	b.StartTimer()
+
+	b.RunParallel(body)
+
+	b.StopTimer()
+
+	if c != nil {
+		c.stop(b, eventsPerOp)
+	}
+
+	if p != nil {
+		p.stop(b)
+	}
+
+	b.StartTimer()
+}
+
 type logBenchmark interface {
 	new(w io.Writer) logBenchmark
 	newWithCtx(w io.Writer) logBenchmark
+	// newWithGroups returns a logger that has accumulated the fields of
+	// groupFields, nested with the library's native grouping if it has one.
+	newWithGroups(w io.Writer) logBenchmark
+	// newWithLevelSwitch returns a logger at the Info level and a function
+	// that switches it between Info and Debug using the library's atomic
+	// level mechanism. The function is nil if the library has none.
+	newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool))
 	name() string
 	logEvent(msg string)
+	// logEventWarn and logEventError log the event of logEvent at the Warn
+	// and Error levels.
+	logEventWarn(msg string)
+	logEventError(msg string)
 	logEventFmt(msg string, args ...any)
 	logEventCtx(msg string)
 	logEventCtxWeak(msg string)
+	// logEventCtxWarn and logEventCtxError log the event of logEventCtx at the
+	// Warn and Error levels.
+	logEventCtxWarn(msg string)
+	logEventCtxError(msg string)
+	// logEventErrorStack logs an event at the Error level with err and a
+	// stack trace of the call, using the library's own stack trace field if
+	// it has one.
+	logEventErrorStack(msg string, err error)
+	// logEventKV logs an event with arbitrary fields given as alternating
+	// key-value pairs, using the weakly typed API of the library.
+	logEventKV(msg string, keysAndValues ...any)
+	// logEventField logs an event with the single field selected by kind,
+	// using the most specific API of the library for that type.
+	logEventField(msg string, kind fieldKind)
+	// withTraceContext returns a copy of ctx carrying the logger, or the
+	// fields its handler reads, for a request with a trace and span ID.
+	withTraceContext(ctx context.Context) context.Context
+	logEventFromContext(ctx context.Context, msg string)
+	// logEventLazy logs an event with a "digest" field computed by
+	// expensiveDigest, deferred through the library's lazy mechanism.
+	logEventLazy(msg string)
+	// logRequest derives a child logger with the request fields, logs
+	// requestEvents events with it and discards it, as a handler would for
+	// each HTTP request.
+	logRequest(msg string)
+	// logEventGroups logs an event with the fields of groupFields, nested
+	// with the library's native grouping if it has one.
+	logEventGroups(msg string)
 	logDisabled(msg string)
 	logDisabledFmt(msg string, args ...any)
 	logDisabledCtx(msg string)
+	// logDisabledCtxGuarded logs like logDisabledCtx, but only after checking
+	// that the Debug level is enabled with the library's check API, so that
+	// the fields are never built.
+	logDisabledCtxGuarded(msg string)
 	logDisabledCtxWeak(msg string)
+	logDisabledLazy(msg string)
 }
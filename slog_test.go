@@ -7,6 +7,23 @@ import (
 	"log/slog"
 )
 
+// slogUser is a user with a slog.LogValuer. It is only logged by the marshaler
+// side of the object field scenarios, so the Slog scenarios that log user
+// keep encoding it through reflection.
+type slogUser user
+
+func (u slogUser) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("name", u.Name),
+		slog.Int("age", u.Age),
+		slog.Time("dob", u.DOB),
+	)
+}
+
+func (lazyDigest) LogValue() slog.Value {
+	return slog.StringValue(expensiveDigest())
+}
+
 func slogAttrs() []slog.Attr {
 	return []slog.Attr{
 		slog.Int("bytes", ctxBodyBytes),
@@ -21,18 +38,91 @@ func slogAttrs() []slog.Attr {
 	}
 }
 
+func slogGroups() []slog.Attr {
+	return []slog.Attr{
+		slogHTTP(),
+		slog.Group("db",
+			slog.String("system", grpDBSystem),
+			slog.Int("rows", grpDBRows),
+		),
+	}
+}
+
+func slogHTTP() slog.Attr {
+	return slog.Group("http",
+		slog.Group("request",
+			slog.String("method", reqMethod),
+			slog.String("path", reqPath),
+		),
+		slog.Group("response",
+			slog.Int("status_code", grpStatusCode),
+		),
+	)
+}
+
+// slogWithGroups adds the http group to l as an attribute and the db group
+// with WithGroup, which qualifies every attribute added after it.
+func slogWithGroups(l *slog.Logger) *slog.Logger {
+	return l.With(slogHTTP()).
+		WithGroup("db").
+		With(slog.String("system", grpDBSystem), slog.Int("rows", grpDBRows))
+}
+
 func newSlog(w io.Writer) *slog.Logger {
 	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
 	}))
 }
 
+func newSlogWithLevel(w io.Writer, level slog.Leveler) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level: level,
+	}))
+}
+
 func newSlogWithCtx(w io.Writer, attr []slog.Attr) *slog.Logger {
 	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
 	}).WithAttrs(attr))
 }
 
+type slogTraceKey struct{}
+
+// slogTraceHandler adds the trace and span IDs found in the context to each
+// record, the way tracing integrations for slog do.
+type slogTraceHandler struct {
+	slog.Handler
+}
+
+func (h slogTraceHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ids, ok := ctx.Value(slogTraceKey{}).([2]string); ok {
+		r.AddAttrs(
+			slog.String("trace_id", ids[0]),
+			slog.String("span_id", ids[1]),
+		)
+	}
+
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h slogTraceHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return slogTraceHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h slogTraceHandler) WithGroup(name string) slog.Handler {
+	return slogTraceHandler{h.Handler.WithGroup(name)}
+}
+
+func slogLevelSwitch(level *slog.LevelVar) func(debug bool) {
+	return func(debug bool) {
+		if debug {
+			level.Set(slog.LevelDebug)
+		} else {
+			level.Set(slog.LevelInfo)
+		}
+	}
+}
+
 type slogBench struct {
 	l *slog.Logger
 }
@@ -49,6 +139,20 @@ func (b *slogBench) newWithCtx(w io.Writer) logBenchmark {
 	}
 }
 
+func (b *slogBench) newWithGroups(w io.Writer) logBenchmark {
+	return &slogBench{
+		l: slogWithGroups(newSlog(w)),
+	}
+}
+
+func (b *slogBench) newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool)) {
+	level := &slog.LevelVar{}
+
+	return &slogBench{
+		l: newSlogWithLevel(w, level),
+	}, slogLevelSwitch(level)
+}
+
 func (b *slogBench) name() string {
 	return "Slog"
 }
@@ -57,6 +161,14 @@ func (b *slogBench) logEvent(msg string) {
 	b.l.Info(msg)
 }
 
+func (b *slogBench) logEventWarn(msg string) {
+	b.l.Warn(msg)
+}
+
+func (b *slogBench) logEventError(msg string) {
+	b.l.Error(msg)
+}
+
 func (b *slogBench) logEventFmt(msg string, args ...any) {
 	b.l.Info(fmt.Sprintf(msg, args...))
 }
@@ -74,6 +186,94 @@ func (b *slogBench) logEventCtxWeak(msg string) {
 	b.l.Info(msg, alternatingKeyValuePairs()...)
 }
 
+func (b *slogBench) logEventCtxWarn(msg string) {
+	b.l.LogAttrs(
+		context.Background(),
+		slog.LevelWarn,
+		msg,
+		slogAttrs()...,
+	)
+}
+
+func (b *slogBench) logEventCtxError(msg string) {
+	b.l.LogAttrs(
+		context.Background(),
+		slog.LevelError,
+		msg,
+		slogAttrs()...,
+	)
+}
+
+func (b *slogBench) logEventErrorStack(msg string, err error) {
+	b.l.LogAttrs(
+		context.Background(),
+		slog.LevelError,
+		msg,
+		slog.Any("error", err),
+		slog.String("stack", stackTrace()),
+	)
+}
+
+func (b *slogBench) logEventKV(msg string, keysAndValues ...any) {
+	b.l.Info(msg, keysAndValues...)
+}
+
+func (b *slogBench) logEventField(msg string, kind fieldKind) {
+	var attr slog.Attr
+
+	switch kind {
+	case fieldInt:
+		attr = slog.Int("bytes", ctxBodyBytes)
+	case fieldString:
+		attr = slog.String("request", ctxRequest)
+	case fieldFloat64:
+		attr = slog.Float64("elapsed_time_ms", ctxTimeElapsedMs)
+	case fieldBool:
+		attr = slog.Bool("cache_hit", ctxCacheHit)
+	case fieldTime:
+		attr = slog.Time("now", ctxTime)
+	case fieldDuration:
+		attr = slog.Duration("timeout", ctxTimeout)
+	case fieldObject:
+		attr = slog.Any("user", slogUser(ctxUser))
+	default:
+		attr = slog.Any(kind.keyValue())
+	}
+
+	b.l.LogAttrs(context.Background(), slog.LevelInfo, msg, attr)
+}
+
+func (b *slogBench) withTraceContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, slogTraceKey{}, [2]string{ctxTraceID, ctxSpanID})
+
+	return contextWithLogger(ctx, slog.New(slogTraceHandler{b.l.Handler()}))
+}
+
+func (b *slogBench) logEventFromContext(ctx context.Context, msg string) {
+	loggerFromContext[*slog.Logger](ctx).InfoContext(ctx, msg)
+}
+
+func (b *slogBench) logEventLazy(msg string) {
+	b.l.Info(msg, slog.Any("digest", lazyDigest{}))
+}
+
+func (b *slogBench) logRequest(msg string) {
+	l := b.l.With(
+		slog.String("request_id", reqID),
+		slog.String("method", reqMethod),
+		slog.String("path", reqPath),
+		slog.String("remote_addr", reqRemoteAddr),
+	)
+
+	for i := 0; i < requestEvents; i++ {
+		l.Info(msg)
+	}
+}
+
+func (b *slogBench) logEventGroups(msg string) {
+	b.l.LogAttrs(context.Background(), slog.LevelInfo, msg, slogGroups()...)
+}
+
 func (b *slogBench) logDisabled(msg string) {
 	b.l.Debug(msg)
 }
@@ -91,6 +291,21 @@ func (b *slogBench) logDisabledCtx(msg string) {
 	)
 }
 
+func (b *slogBench) logDisabledCtxGuarded(msg string) {
+	if b.l.Enabled(context.Background(), slog.LevelDebug) {
+		b.l.LogAttrs(
+			context.Background(),
+			slog.LevelDebug,
+			msg,
+			slogAttrs()...,
+		)
+	}
+}
+
 func (b *slogBench) logDisabledCtxWeak(msg string) {
 	b.l.Debug(msg, alternatingKeyValuePairs()...)
 }
+
+func (b *slogBench) logDisabledLazy(msg string) {
+	b.l.Debug(msg, slog.Any("digest", lazyDigest{}))
+}
@@ -4,6 +4,7 @@ import (
 	"io"
 	"log/slog"
 
+	"go.uber.org/zap"
 	"go.uber.org/zap/exp/zapslog"
 )
 
@@ -38,6 +39,21 @@ func (b *slogZapBench) newWithCtx(w io.Writer) logBenchmark {
 	}
 }
 
+func (b *slogZapBench) newWithGroups(w io.Writer) logBenchmark {
+	return &slogBench{
+		l: slogWithGroups(newSlogZap(w)),
+	}
+}
+
+func (b *slogZapBench) newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool)) {
+	level := zap.NewAtomicLevelAt(zap.InfoLevel)
+	l := newZapWithLevel(w, level)
+
+	return &slogBench{
+		l: slog.New(zapslog.NewHandler(l.Core(), nil)),
+	}, zapLevelSwitch(level)
+}
+
 func (b *slogZapBench) name() string {
 	return "SlogZap"
 }
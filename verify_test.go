@@ -0,0 +1,302 @@
+package bench
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+// logEvent is a decoded log line. The differences between libraries are
+// normalized: the message is stored under "msg" and the fields that Apex
+// nests under "fields" are lifted to the top level.
+type logEvent struct {
+	fields map[string]any
+	// logfmt is set for Logf, whose values are all decoded as strings.
+	logfmt bool
+}
+
+// knownOutputProblems describes the incorrect output that a library is known
+// to write in a scenario, keyed by "Scenario/Library". These are findings
+// about the libraries, so their benchmarks still run and report them, and
+// the tests that check the output fail if a known problem no longer occurs.
+var knownOutputProblems = map[string]string{
+	"EventCtxWeak/Phuslog":   "Any writes ObjectMarshaler values without their braces",
+	"EventNestedMap/Phuslog": "maps passed as key-value pairs are encoded as a JSON string",
+	"EventEscaping/Phuslog":  "invalid UTF-8 is written unchanged, so the line is not valid JSON",
+	"EventEscaping/Logf":     "values without spaces are not quoted, so their newlines split the event",
+}
+
+// knownOutputProblem returns the known problem of the library in the
+// scenario of b, a sub-benchmark named "BenchmarkScenario/Library".
+func knownOutputProblem(b *testing.B) (string, bool) {
+	known, ok := knownOutputProblems[strings.TrimPrefix(b.Name(), "Benchmark")]
+	return known, ok
+}
+
+var eventKeyAliases = map[string]string{
+	"message":   "msg",
+	"lvl":       "level",
+	"t":         "time",
+	"timestamp": "time",
+}
+
+// parseEvent decodes a single JSON or logfmt line without its trailing
+// newline.
+func parseEvent(line []byte) (logEvent, error) {
+	if !utf8.Valid(line) {
+		return logEvent{}, errors.New("line is not valid UTF-8")
+	}
+
+	if len(line) > 0 && line[0] != '{' {
+		fields, err := parseLogfmt(string(line))
+		if err != nil {
+			return logEvent{}, err
+		}
+
+		return logEvent{fields: normalizeKeys(fields), logfmt: true}, nil
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return logEvent{}, fmt.Errorf("line is not valid JSON: %w", err)
+	}
+
+	if nested, ok := fields["fields"].(map[string]any); ok {
+		delete(fields, "fields")
+		for k, v := range nested {
+			fields[k] = v
+		}
+	}
+
+	return logEvent{fields: normalizeKeys(fields)}, nil
+}
+
+func normalizeKeys(fields map[string]any) map[string]any {
+	for from, to := range eventKeyAliases {
+		if v, ok := fields[from]; ok {
+			delete(fields, from)
+			fields[to] = v
+		}
+	}
+
+	return fields
+}
+
+// parseLogfmt decodes a logfmt line as written by Logf, where quoted values
+// use JSON string escapes.
+func parseLogfmt(line string) (map[string]any, error) {
+	fields := make(map[string]any)
+
+	for line = strings.TrimSpace(line); line != ""; line = strings.TrimLeft(line, " ") {
+		eq := strings.IndexByte(line, '=')
+		if eq <= 0 {
+			return nil, fmt.Errorf("malformed logfmt pair: %q", line)
+		}
+
+		key, rest := line[:eq], line[eq+1:]
+
+		if !strings.HasPrefix(rest, `"`) {
+			end := strings.IndexByte(rest, ' ')
+			if end == -1 {
+				end = len(rest)
+			}
+
+			fields[key], line = rest[:end], rest[end:]
+
+			continue
+		}
+
+		end := 1
+		for ; end < len(rest); end++ {
+			if rest[end] == '\\' {
+				end++
+			} else if rest[end] == '"' {
+				break
+			}
+		}
+
+		if end >= len(rest) {
+			return nil, fmt.Errorf("unterminated logfmt value for %q", key)
+		}
+
+		var v string
+		if err := json.Unmarshal([]byte(rest[:end+1]), &v); err != nil {
+			return nil, fmt.Errorf("malformed logfmt value for %q: %w", key, err)
+		}
+
+		fields[key], line = v, rest[end+1:]
+	}
+
+	return fields, nil
+}
+
+// levelAliases maps the four-letter level names of Log15 to the full ones.
+var levelAliases = map[string]string{
+	"dbug": "debug",
+	"eror": "error",
+	"crit": "critical",
+}
+
+// checkLevel checks that the event was logged at the given level, which
+// libraries spell in different cases and lengths, such as "WARN", "warning"
+// and "warn", returning a description of the difference if it was not.
+func (e logEvent) checkLevel(level string) string {
+	got, _ := e.fields["level"].(string)
+
+	normalized := strings.ToLower(got)
+	if alias, ok := levelAliases[normalized]; ok {
+		normalized = alias
+	}
+
+	if !strings.HasPrefix(normalized, level) {
+		return fmt.Sprintf("expected the %s level, got %q", level, got)
+	}
+
+	return ""
+}
+
+// check compares a decoded field to the value that was logged, returning a
+// description of the difference or an empty string if they match. Values
+// are compared through an encoding/json round trip so that numbers, maps and
+// invalid UTF-8 are represented the same way on both sides.
+func (e logEvent) check(key string, want any) string {
+	got, ok := e.fields[key]
+	if !ok {
+		return fmt.Sprintf("field %q is missing", key)
+	}
+
+	if e.logfmt {
+		if s := replaceInvalidUTF8(fmt.Sprint(want)); got != s {
+			return fmt.Sprintf("field %q: expected %q, got %q", key, s, got)
+		}
+
+		return ""
+	}
+
+	var expected any
+
+	if b, err := json.Marshal(want); err != nil {
+		return fmt.Sprintf("field %q: cannot encode expected value: %v", key, err)
+	} else if err := json.Unmarshal(b, &expected); err != nil {
+		return fmt.Sprintf("field %q: cannot decode expected value: %v", key, err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		return fmt.Sprintf("field %q: expected %s, got %s", key, abbrev(expected), abbrev(got))
+	}
+
+	return ""
+}
+
+// checkOutput verifies that out holds exactly one event with the given
+// message and fields, returning the problems found.
+func checkOutput(out []byte, msg string, keysAndValues []any) []string {
+	lines := bytes.Split(bytes.TrimSuffix(out, []byte("\n")), []byte("\n"))
+	if len(lines) != 1 {
+		return []string{fmt.Sprintf("event was written as %d lines", len(lines))}
+	}
+
+	e, err := parseEvent(lines[0])
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var problems []string
+
+	if p := e.check("msg", msg); p != "" {
+		problems = append(problems, p)
+	}
+
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		if p := e.check(keysAndValues[i].(string), keysAndValues[i+1]); p != "" {
+			problems = append(problems, p)
+		}
+	}
+
+	return problems
+}
+
+// checkLevelOutput verifies that out holds exactly one event with the given
+// message, logged at the given level, returning the problems found.
+func checkLevelOutput(out []byte, msg, level string) []string {
+	problems := checkOutput(out, msg, nil)
+
+	if e, err := parseEvent(bytes.TrimSuffix(out, []byte("\n"))); err == nil {
+		if p := e.checkLevel(level); p != "" {
+			problems = append(problems, p)
+		}
+	}
+
+	return problems
+}
+
+// checkFmtOutput verifies that out holds exactly one event whose message is
+// format rendered with args, returning the problems found.
+func checkFmtOutput(out []byte, format string, args []any) []string {
+	return checkOutput(out, fmt.Sprintf(format, args...), nil)
+}
+
+// checkGroupsOutput verifies that out holds exactly one event with the
+// nested fields of groupFields, returning the problems found. Logfmt events
+// are expected to hold them with dotted keys instead.
+func checkGroupsOutput(out []byte, msg string) []string {
+	if len(out) > 0 && out[0] != '{' {
+		return checkOutput(out, msg, flattenFields("", groupFields()))
+	}
+
+	return checkOutput(out, msg, groupKeyValuePairs())
+}
+
+// flattenFields returns nested fields as key-value pairs sorted by their
+// dotted keys, such as "http.request.method", for libraries that cannot nest.
+func flattenFields(prefix string, fields map[string]any) []any {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	var keysAndValues []any
+
+	for _, k := range keys {
+		if nested, ok := fields[k].(map[string]any); ok {
+			keysAndValues = append(keysAndValues, flattenFields(prefix+k+".", nested)...)
+		} else {
+			keysAndValues = append(keysAndValues, prefix+k, fields[k])
+		}
+	}
+
+	return keysAndValues
+}
+
+// replaceInvalidUTF8 replaces every invalid byte in s with utf8.RuneError,
+// the way encoding/json decodes them.
+func replaceInvalidUTF8(s string) string {
+	if utf8.ValidString(s) {
+		return s
+	}
+
+	var sb strings.Builder
+	for _, r := range s {
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// abbrev formats v for an error message, truncating long values.
+func abbrev(v any) string {
+	s := fmt.Sprintf("%#v", v)
+	if len(s) > 80 {
+		s = s[:80] + "..."
+	}
+
+	return s
+}
@@ -1,6 +1,7 @@
 package bench
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -40,14 +41,62 @@ func zapFields() []zap.Field {
 	}
 }
 
+var (
+	zapHTTPRequest = zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		enc.AddString("method", reqMethod)
+		enc.AddString("path", reqPath)
+
+		return nil
+	})
+	zapHTTPResponse = zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		enc.AddInt("status_code", grpStatusCode)
+
+		return nil
+	})
+	zapHTTP = zapcore.ObjectMarshalerFunc(func(enc zapcore.ObjectEncoder) error {
+		if err := enc.AddObject("request", zapHTTPRequest); err != nil {
+			return err
+		}
+
+		return enc.AddObject("response", zapHTTPResponse)
+	})
+)
+
+// zapGroups nests the http group with an object and the db group with a
+// namespace, which holds every field added after it.
+func zapGroups() []zap.Field {
+	return []zap.Field{
+		zap.Object("http", zapHTTP),
+		zap.Namespace("db"),
+		zap.String("system", grpDBSystem),
+		zap.Int("rows", grpDBRows),
+	}
+}
+
+// zapSugarGroups returns the fields of zapGroups as arguments for the
+// SugaredLogger, which passes strongly typed fields through unchanged.
+func zapSugarGroups() []any {
+	fields := zapGroups()
+
+	args := make([]any, len(fields))
+	for i, f := range fields {
+		args[i] = f
+	}
+
+	return args
+}
+
 func newZap(w io.Writer) *zap.Logger {
-	stdout := zapcore.AddSync(w)
+	return newZapWithLevel(w, zap.NewAtomicLevelAt(zap.InfoLevel))
+}
 
-	level := zap.NewAtomicLevelAt(zap.InfoLevel)
+func newZapWithLevel(w io.Writer, level zap.AtomicLevel) *zap.Logger {
+	stdout := zapcore.AddSync(w)
 
 	productionCfg := zap.NewProductionEncoderConfig()
 	productionCfg.TimeKey = "time"
 	productionCfg.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+	productionCfg.StacktraceKey = "stack"
 
 	jsonEncoder := zapcore.NewJSONEncoder(productionCfg)
 
@@ -55,7 +104,19 @@ func newZap(w io.Writer) *zap.Logger {
 		zapcore.NewCore(jsonEncoder, stdout, level),
 	)
 
-	return zap.New(core)
+	// Like zap.NewProduction, capture a stack trace for every event at the
+	// Error level and above.
+	return zap.New(core, zap.AddStacktrace(zapcore.ErrorLevel))
+}
+
+func zapLevelSwitch(level zap.AtomicLevel) func(debug bool) {
+	return func(debug bool) {
+		if debug {
+			level.SetLevel(zap.DebugLevel)
+		} else {
+			level.SetLevel(zap.InfoLevel)
+		}
+	}
 }
 
 type zapBench struct {
@@ -74,6 +135,20 @@ func (b *zapBench) newWithCtx(w io.Writer) logBenchmark {
 	}
 }
 
+func (b *zapBench) newWithGroups(w io.Writer) logBenchmark {
+	return &zapBench{
+		l: newZap(w).With(zapGroups()...),
+	}
+}
+
+func (b *zapBench) newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool)) {
+	level := zap.NewAtomicLevelAt(zap.InfoLevel)
+
+	return &zapBench{
+		l: newZapWithLevel(w, level),
+	}, zapLevelSwitch(level)
+}
+
 func (b *zapBench) name() string {
 	return "Zap"
 }
@@ -82,6 +157,14 @@ func (b *zapBench) logEvent(msg string) {
 	b.l.Info(msg)
 }
 
+func (b *zapBench) logEventWarn(msg string) {
+	b.l.Warn(msg)
+}
+
+func (b *zapBench) logEventError(msg string) {
+	b.l.Error(msg)
+}
+
 func (b *zapBench) logEventFmt(msg string, args ...any) {
 	b.l.Info(fmt.Sprintf(msg, args...))
 }
@@ -94,6 +177,92 @@ func (b *zapBench) logEventCtxWeak(msg string) {
 	b.l.Sugar().Infow(msg, alternatingKeyValuePairs()...)
 }
 
+func (b *zapBench) logEventCtxWarn(msg string) {
+	b.l.Warn(msg, zapFields()...)
+}
+
+func (b *zapBench) logEventCtxError(msg string) {
+	b.l.Error(msg, zapFields()...)
+}
+
+func (b *zapBench) logEventErrorStack(msg string, err error) {
+	// The stack trace is added by zap.AddStacktrace.
+	b.l.Error(msg, zap.Error(err))
+}
+
+func (b *zapBench) logEventKV(msg string, keysAndValues ...any) {
+	b.l.Sugar().Infow(msg, keysAndValues...)
+}
+
+func (b *zapBench) logEventField(msg string, kind fieldKind) {
+	var f zap.Field
+
+	switch kind {
+	case fieldInt:
+		f = zap.Int("bytes", ctxBodyBytes)
+	case fieldString:
+		f = zap.String("request", ctxRequest)
+	case fieldFloat64:
+		f = zap.Float64("elapsed_time_ms", ctxTimeElapsedMs)
+	case fieldBool:
+		f = zap.Bool("cache_hit", ctxCacheHit)
+	case fieldTime:
+		f = zap.Time("now", ctxTime)
+	case fieldDuration:
+		f = zap.Duration("timeout", ctxTimeout)
+	case fieldError:
+		f = zap.Error(ctxErr)
+	case fieldStrings:
+		f = zap.Strings("months", ctxMonths)
+	case fieldInts:
+		f = zap.Ints("primes", ctxFirst10Primes)
+	case fieldObject:
+		f = zap.Object("user", ctxUser)
+	case fieldObjectReflect:
+		f = zap.Reflect("user", ctxPlainUser)
+	case fieldObjects:
+		f = zap.Array("users", ctxUsers)
+	case fieldObjectsReflect:
+		f = zap.Reflect("users", ctxPlainUsers)
+	case fieldMap:
+		f = zap.Any("labels", ctxLabels)
+	}
+
+	b.l.Info(msg, f)
+}
+
+func (b *zapBench) withTraceContext(ctx context.Context) context.Context {
+	return contextWithLogger(ctx, b.l.With(
+		zap.String("trace_id", ctxTraceID),
+		zap.String("span_id", ctxSpanID),
+	))
+}
+
+func (b *zapBench) logEventFromContext(ctx context.Context, msg string) {
+	loggerFromContext[*zap.Logger](ctx).Info(msg)
+}
+
+func (b *zapBench) logEventLazy(msg string) {
+	b.l.Info(msg, zap.Stringer("digest", lazyDigest{}))
+}
+
+func (b *zapBench) logRequest(msg string) {
+	l := b.l.With(
+		zap.String("request_id", reqID),
+		zap.String("method", reqMethod),
+		zap.String("path", reqPath),
+		zap.String("remote_addr", reqRemoteAddr),
+	)
+
+	for i := 0; i < requestEvents; i++ {
+		l.Info(msg)
+	}
+}
+
+func (b *zapBench) logEventGroups(msg string) {
+	b.l.Info(msg, zapGroups()...)
+}
+
 func (b *zapBench) logDisabled(msg string) {
 	b.l.Debug(msg)
 }
@@ -106,10 +275,20 @@ func (b *zapBench) logDisabledCtx(msg string) {
 	b.l.Debug(msg, zapFields()...)
 }
 
+func (b *zapBench) logDisabledCtxGuarded(msg string) {
+	if ce := b.l.Check(zap.DebugLevel, msg); ce != nil {
+		ce.Write(zapFields()...)
+	}
+}
+
 func (b *zapBench) logDisabledCtxWeak(msg string) {
 	b.l.Sugar().Debugw(msg, alternatingKeyValuePairs()...)
 }
 
+func (b *zapBench) logDisabledLazy(msg string) {
+	b.l.Debug(msg, zap.Stringer("digest", lazyDigest{}))
+}
+
 type zapSugarBench struct {
 	l *zap.SugaredLogger
 }
@@ -126,6 +305,20 @@ func (b *zapSugarBench) newWithCtx(w io.Writer) logBenchmark {
 	}
 }
 
+func (b *zapSugarBench) newWithGroups(w io.Writer) logBenchmark {
+	return &zapSugarBench{
+		l: newZap(w).Sugar().With(zapSugarGroups()...),
+	}
+}
+
+func (b *zapSugarBench) newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool)) {
+	level := zap.NewAtomicLevelAt(zap.InfoLevel)
+
+	return &zapSugarBench{
+		l: newZapWithLevel(w, level).Sugar(),
+	}, zapLevelSwitch(level)
+}
+
 func (b *zapSugarBench) name() string {
 	return "ZapSugar"
 }
@@ -134,6 +327,14 @@ func (b *zapSugarBench) logEvent(msg string) {
 	b.l.Info(msg)
 }
 
+func (b *zapSugarBench) logEventWarn(msg string) {
+	b.l.Warn(msg)
+}
+
+func (b *zapSugarBench) logEventError(msg string) {
+	b.l.Error(msg)
+}
+
 func (b *zapSugarBench) logEventFmt(msg string, args ...any) {
 	b.l.Infof(msg, args...)
 }
@@ -146,6 +347,60 @@ func (b *zapSugarBench) logEventCtxWeak(msg string) {
 	b.logEventCtx(msg)
 }
 
+func (b *zapSugarBench) logEventCtxWarn(msg string) {
+	b.l.Warnw(msg, alternatingKeyValuePairs()...)
+}
+
+func (b *zapSugarBench) logEventCtxError(msg string) {
+	b.l.Errorw(msg, alternatingKeyValuePairs()...)
+}
+
+func (b *zapSugarBench) logEventErrorStack(msg string, err error) {
+	// The stack trace is added by zap.AddStacktrace.
+	b.l.Errorw(msg, "error", err)
+}
+
+func (b *zapSugarBench) logEventKV(msg string, keysAndValues ...any) {
+	b.l.Infow(msg, keysAndValues...)
+}
+
+func (b *zapSugarBench) logEventField(msg string, kind fieldKind) {
+	key, value := kind.keyValue()
+	b.l.Infow(msg, key, value)
+}
+
+func (b *zapSugarBench) withTraceContext(ctx context.Context) context.Context {
+	return contextWithLogger(ctx, b.l.With(
+		"trace_id", ctxTraceID,
+		"span_id", ctxSpanID,
+	))
+}
+
+func (b *zapSugarBench) logEventFromContext(ctx context.Context, msg string) {
+	loggerFromContext[*zap.SugaredLogger](ctx).Info(msg)
+}
+
+func (b *zapSugarBench) logEventLazy(msg string) {
+	b.l.Infow(msg, "digest", lazyDigest{})
+}
+
+func (b *zapSugarBench) logRequest(msg string) {
+	l := b.l.With(
+		"request_id", reqID,
+		"method", reqMethod,
+		"path", reqPath,
+		"remote_addr", reqRemoteAddr,
+	)
+
+	for i := 0; i < requestEvents; i++ {
+		l.Info(msg)
+	}
+}
+
+func (b *zapSugarBench) logEventGroups(msg string) {
+	b.l.Infow(msg, zapSugarGroups()...)
+}
+
 func (b *zapSugarBench) logDisabled(msg string) {
 	b.l.Debug(msg)
 }
@@ -158,6 +413,16 @@ func (b *zapSugarBench) logDisabledCtx(msg string) {
 	b.l.Debugw(msg, alternatingKeyValuePairs()...)
 }
 
+func (b *zapSugarBench) logDisabledCtxGuarded(msg string) {
+	if b.l.Level().Enabled(zap.DebugLevel) {
+		b.l.Debugw(msg, alternatingKeyValuePairs()...)
+	}
+}
+
 func (b *zapSugarBench) logDisabledCtxWeak(msg string) {
 	b.logDisabledCtx(msg)
 }
+
+func (b *zapSugarBench) logDisabledLazy(msg string) {
+	b.l.Debugw(msg, "digest", lazyDigest{})
+}
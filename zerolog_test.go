@@ -1,6 +1,7 @@
 package bench
 
 import (
+	"context"
 	"io"
 	"time"
 
@@ -34,6 +35,12 @@ func zerologFields(e *zerolog.Event) *zerolog.Event {
 	return e
 }
 
+// zerologDigest adds the expensive digest field, which Func only computes
+// when the event is enabled.
+func zerologDigest(e *zerolog.Event) {
+	e.Str("digest", expensiveDigest())
+}
+
 func zerologCtx(c zerolog.Context) zerolog.Context {
 	c.
 		Int("bytes", ctxBodyBytes).
@@ -49,6 +56,21 @@ func zerologCtx(c zerolog.Context) zerolog.Context {
 	return c
 }
 
+func zerologHTTP() *zerolog.Event {
+	return zerolog.Dict().
+		Dict("request", zerolog.Dict().
+			Str("method", reqMethod).
+			Str("path", reqPath)).
+		Dict("response", zerolog.Dict().
+			Int("status_code", grpStatusCode))
+}
+
+func zerologDB() *zerolog.Event {
+	return zerolog.Dict().
+		Str("system", grpDBSystem).
+		Int("rows", grpDBRows)
+}
+
 func newZerolog(w io.Writer) zerolog.Logger {
 	zerolog.TimeFieldFormat = time.RFC3339Nano
 	return zerolog.New(w).Level(zerolog.InfoLevel).With().Timestamp().Logger()
@@ -70,6 +92,30 @@ func (b *zerologBench) newWithCtx(w io.Writer) logBenchmark {
 	}
 }
 
+func (b *zerologBench) newWithGroups(w io.Writer) logBenchmark {
+	return &zerologBench{
+		l: newZerolog(w).With().
+			Dict("http", zerologHTTP()).
+			Dict("db", zerologDB()).
+			Logger(),
+	}
+}
+
+// newWithLevelSwitch leaves the logger at Debug and switches the global level
+// instead, since a zerolog.Logger cannot change its own level in place. Debug
+// is also zerolog's default global level.
+func (b *zerologBench) newWithLevelSwitch(w io.Writer) (logBenchmark, func(debug bool)) {
+	return &zerologBench{
+		l: newZerolog(w).Level(zerolog.DebugLevel),
+	}, func(debug bool) {
+		if debug {
+			zerolog.SetGlobalLevel(zerolog.DebugLevel)
+		} else {
+			zerolog.SetGlobalLevel(zerolog.InfoLevel)
+		}
+	}
+}
+
 func (b *zerologBench) name() string {
 	return "Zerolog"
 }
@@ -78,6 +124,14 @@ func (b *zerologBench) logEvent(msg string) {
 	b.l.Info().Msg(msg)
 }
 
+func (b *zerologBench) logEventWarn(msg string) {
+	b.l.Warn().Msg(msg)
+}
+
+func (b *zerologBench) logEventError(msg string) {
+	b.l.Error().Msg(msg)
+}
+
 func (b *zerologBench) logEventFmt(msg string, args ...any) {
 	b.l.Info().Msgf(msg, args...)
 }
@@ -90,6 +144,95 @@ func (b *zerologBench) logEventCtxWeak(msg string) {
 	b.l.Info().Fields(alternatingKeyValuePairs()).Msg(msg)
 }
 
+func (b *zerologBench) logEventCtxWarn(msg string) {
+	zerologFields(b.l.Warn()).Msg(msg)
+}
+
+func (b *zerologBench) logEventCtxError(msg string) {
+	zerologFields(b.l.Error()).Msg(msg)
+}
+
+func (b *zerologBench) logEventErrorStack(msg string, err error) {
+	b.l.Error().Err(err).Str("stack", stackTrace()).Msg(msg)
+}
+
+func (b *zerologBench) logEventKV(msg string, keysAndValues ...any) {
+	b.l.Info().Fields(keysAndValues).Msg(msg)
+}
+
+func (b *zerologBench) logEventField(msg string, kind fieldKind) {
+	e := b.l.Info()
+
+	switch kind {
+	case fieldInt:
+		e.Int("bytes", ctxBodyBytes)
+	case fieldString:
+		e.Str("request", ctxRequest)
+	case fieldFloat64:
+		e.Float64("elapsed_time_ms", ctxTimeElapsedMs)
+	case fieldBool:
+		e.Bool("cache_hit", ctxCacheHit)
+	case fieldTime:
+		e.Time("now", ctxTime)
+	case fieldDuration:
+		e.Dur("timeout", ctxTimeout)
+	case fieldError:
+		e.Err(ctxErr)
+	case fieldStrings:
+		e.Strs("months", ctxMonths)
+	case fieldInts:
+		e.Ints("primes", ctxFirst10Primes)
+	case fieldObject:
+		e.Object("user", ctxUser)
+	case fieldObjectReflect:
+		e.Interface("user", ctxPlainUser)
+	case fieldObjects:
+		e.Array("users", ctxUsers)
+	case fieldObjectsReflect:
+		e.Interface("users", ctxPlainUsers)
+	case fieldMap:
+		e.Interface("labels", ctxLabels)
+	}
+
+	e.Msg(msg)
+}
+
+func (b *zerologBench) withTraceContext(ctx context.Context) context.Context {
+	return b.l.With().
+		Str("trace_id", ctxTraceID).
+		Str("span_id", ctxSpanID).
+		Logger().
+		WithContext(ctx)
+}
+
+func (b *zerologBench) logEventFromContext(ctx context.Context, msg string) {
+	zerolog.Ctx(ctx).Info().Msg(msg)
+}
+
+func (b *zerologBench) logEventLazy(msg string) {
+	b.l.Info().Func(zerologDigest).Msg(msg)
+}
+
+func (b *zerologBench) logRequest(msg string) {
+	l := b.l.With().
+		Str("request_id", reqID).
+		Str("method", reqMethod).
+		Str("path", reqPath).
+		Str("remote_addr", reqRemoteAddr).
+		Logger()
+
+	for i := 0; i < requestEvents; i++ {
+		l.Info().Msg(msg)
+	}
+}
+
+func (b *zerologBench) logEventGroups(msg string) {
+	b.l.Info().
+		Dict("http", zerologHTTP()).
+		Dict("db", zerologDB()).
+		Msg(msg)
+}
+
 func (b *zerologBench) logDisabled(msg string) {
 	b.l.Debug().Msg(msg)
 }
@@ -102,6 +245,16 @@ func (b *zerologBench) logDisabledCtx(msg string) {
 	zerologFields(b.l.Debug()).Msg(msg)
 }
 
+func (b *zerologBench) logDisabledCtxGuarded(msg string) {
+	if e := b.l.Debug(); e != nil {
+		zerologFields(e).Msg(msg)
+	}
+}
+
 func (b *zerologBench) logDisabledCtxWeak(msg string) {
 	b.l.Debug().Fields(alternatingKeyValuePairs()).Msg(msg)
 }
+
+func (b *zerologBench) logDisabledLazy(msg string) {
+	b.l.Debug().Func(zerologDigest).Msg(msg)
+}